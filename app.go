@@ -0,0 +1,364 @@
+package main
+
+import (
+	"airpush/auction"
+	"airpush/auction/currency"
+	"airpush/auction/deal"
+	"airpush/auction/dsp"
+	"airpush/auction/event"
+	"airpush/auction/floor"
+	"airpush/auction/metrics"
+	"airpush/auction/notice"
+	"airpush/auction/quality"
+	"airpush/auction/tracking"
+	"airpush/auction/vast"
+	"airpush/server"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// wait for editor to finish writing config file
+const RELOAD_DEBOUNCE = time.Duration(500) * time.Millisecond
+
+// keys applied on start only
+var RESTART_KEYS = []string{"app.setting.", "app.server.", "app.notice.", "app.currency.", "app.vast.", "app.auction.dsp_store", "app.events.", "app.tracking."}
+
+// keys of secrets, value is masked in reload log
+var SECRET_KEYS = []string{"app.server.AdminToken", "app.tracking.secret", ".price.encryption_key", ".price.integrity_key"}
+
+// dsp settings, shadowed by dsp store file when it exists
+const DSP_KEY = "app.auction.dsp."
+
+// app state kept across config reloads
+type app struct {
+	mu sync.Mutex
+	config *viper.Viper
+	logger *logrus.Logger
+	pool *dsp.Pool
+	floors *floor.Floors
+	deals *deal.Deals
+	rates *currency.Rates
+	vast *vast.Store
+	metrics *metrics.Metrics
+	notifier *notice.Notifier
+	stats *auction.Stats
+	events event.Sink
+	tracker *tracking.Tracker
+	watcher *fsnotify.Watcher
+}
+
+// build auction from validated config on shared state
+func (a *app) newAuction(conf *Config) *auction.Auction {
+
+	// ad quality, disabled without config
+	var q *quality.Quality
+	if c := conf.Auction.Quality; c != nil {
+		q = quality.New(
+			quality.SetChecks(c.Checks),
+			quality.SetCreatives(c.Creatives),
+			quality.SetRates(a.rates),
+		)
+	}
+
+	return auction.New(
+		auction.SetPool(a.pool),
+		auction.SetTimeout(time.Duration(conf.Auction.Timeout) * time.Millisecond),
+		auction.SetType(conf.Auction.Type),
+		auction.SetIncrement(conf.Auction.Increment),
+		auction.SetFloors(a.floors),
+		auction.SetDeals(a.deals),
+		auction.SetQuality(q),
+		auction.SetRates(a.rates),
+		auction.SetVast(a.vast),
+		auction.SetMetrics(a.metrics),
+		auction.SetNotifier(a.notifier),
+		auction.SetStats(a.stats),
+		auction.SetEvents(a.events),
+		auction.SetTracker(a.tracker),
+	)
+}
+
+// dropped and failed counts of notice and event queues in stats and metrics
+func (a *app) queues() {
+
+	queues := map[string]interface{}{}
+	if a.notifier != nil {
+		queues[metrics.QUEUE_NOTICE] = a.notifier
+	}
+	if a.events != nil {
+		queues[metrics.QUEUE_EVENTS] = a.events
+	}
+
+	for queue, q := range queues {
+
+		var dropped, failed func() uint64
+		if c, ok := q.(interface{ GetDropped() uint64 }); ok {
+			dropped = c.GetDropped
+			a.stats.Add(queue + ".dropped", dropped)
+		}
+		if c, ok := q.(interface{ GetFailed() uint64 }); ok {
+			failed = c.GetFailed
+			a.stats.Add(queue + ".failed", failed)
+		}
+
+		a.metrics.Queue(queue, dropped, failed)
+	}
+}
+
+// events sink of config, nil when disabled
+func newEvents(conf *Config, logger event.Logger) (event.Sink, error) {
+
+	switch conf.Events.Sink {
+	case SINK_KAFKA:
+		c := conf.Events.Kafka
+		k, err := event.NewKafka(
+			event.SetKafkaBrokers(c.Brokers),
+			event.SetKafkaTopic(c.Topic),
+			event.SetKafkaQueueSize(c.Queue),
+			event.SetKafkaInFlight(c.InFlight),
+			event.SetKafkaBatchSize(c.BatchSize),
+			event.SetKafkaBatchTimeout(time.Duration(c.BatchTimeout) * time.Millisecond),
+			event.SetKafkaCompression(c.Compression),
+			event.SetKafkaRetries(c.Retries),
+			event.SetKafkaLogger(logger),
+		)
+		if err != nil {
+			return nil, err
+		}
+		k.Start()
+		return k, nil
+	case SINK_FILE:
+		c := conf.Events.File
+		f, err := event.NewFile(
+			event.SetDir(c.Dir),
+			event.SetPrefix(c.Prefix),
+			event.SetQueueSize(c.Queue),
+			event.SetMaxSize(int64(c.MaxSize) << 20),
+			event.SetInterval(time.Duration(c.Interval) * time.Second),
+			event.SetFlush(time.Duration(c.Flush) * time.Millisecond),
+			event.SetLogger(logger),
+		)
+		if err != nil {
+			return nil, err
+		}
+		f.Start()
+		return f, nil
+	}
+
+	return nil, nil
+}
+
+// floor rules and deals files of config
+func readRules(conf *Config) ([]floor.Rule, []deal.Deal, error) {
+
+	floors := floor.New()
+	if path := conf.Auction.Floors; path != "" {
+		if err := floors.Load(path); err != nil {
+			return nil, nil, fmt.Errorf("load floors %s err: %s", path, err)
+		}
+	}
+
+	deals := deal.New()
+	if path := conf.Auction.Deals; path != "" {
+		if err := deals.Load(path); err != nil {
+			return nil, nil, fmt.Errorf("load deals %s err: %s", path, err)
+		}
+	}
+
+	return floors.Get(), deals.Get(), nil
+}
+
+// read config file with same env overrides as on start
+func readConfig(file string) (*viper.Viper, error) {
+
+	v := viper.New()
+	v.SetEnvPrefix(APP_NAME)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+	v.SetConfigFile(file)
+
+	return v, v.ReadInConfig()
+}
+
+// reload config file, current config is kept when new one is invalid
+func (a *app) reload(s *server.Server) {
+	defer a.mu.Unlock()
+	a.mu.Lock()
+
+	file := a.config.ConfigFileUsed()
+
+	config, err := readConfig(file)
+	if err == nil {
+		err = a.apply(config, s)
+	}
+	if err != nil {
+		a.logger.Errorf("reload config %s err: %s, current config kept", file, err)
+		return
+	}
+
+	store := a.config.GetString("app.auction.dsp_store")
+	changed, shadowed := diffConfig(a.config, config, storeUsed(store))
+	for _, line := range changed {
+		a.logger.Warnf("config reload: %s", line)
+	}
+	for _, line := range shadowed {
+		a.logger.Warnf("config reload: %s ignored, dsp settings are loaded from store %s, change them by admin api", line, store)
+	}
+	a.config = config
+
+	// reload is rare, do not wait for full log buffer
+	flushLog(a.logger)
+}
+
+// validate whole config before change, then rebuild dsps, rules and auction
+func (a *app) apply(config *viper.Viper, s *server.Server) error {
+
+	// store file is set on start only
+	conf, problems := checkConfig(config, a.config.GetString("app.auction.dsp_store"))
+	if len(problems) > 0 {
+		lines := make([]string, len(problems))
+		for i, p := range problems {
+			lines[i] = p.Error()
+		}
+		return fmt.Errorf("%d problems: %s", len(problems), strings.Join(lines, "; "))
+	}
+
+	rules, deals, err := readRules(conf)
+	if err != nil {
+		return err
+	}
+
+	err = a.pool.Sync(conf.Dsps())
+	if err != nil {
+		return err
+	}
+
+	a.floors.Set(rules)
+	a.deals.Set(deals)
+	s.SwapAuction(a.newAuction(conf))
+
+	return nil
+}
+
+// watch config file, reload on change
+func (a *app) watch(s *server.Server) error {
+
+	file, err := filepath.Abs(a.config.ConfigFileUsed())
+	if err != nil {
+		return err
+	}
+
+	// watch dir, editors replace file on save
+	a.watcher, err = fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	err = a.watcher.Add(filepath.Dir(file))
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		var timer *time.Timer
+		for {
+			select {
+			case ev, ok := <-a.watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(ev.Name) != file || ev.Op & (fsnotify.Write | fsnotify.Create | fsnotify.Rename) == 0 {
+					continue
+				}
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(RELOAD_DEBOUNCE, func() {
+					a.reload(s)
+				})
+			case err, ok := <-a.watcher.Errors:
+				if !ok {
+					return
+				}
+				a.logger.Errorf("watch config err: %s", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// stop watch config
+func (a *app) close() {
+	if a.watcher != nil {
+		_ = a.watcher.Close()
+	}
+}
+
+// changed keys of config, keys applied on start only are marked, secrets are masked
+// dsp keys shadowed by store file are reported apart
+func diffConfig(old, new *viper.Viper, store bool) (changed, shadowed []string) {
+
+	keys := make(map[string]bool)
+	for _, k := range old.AllKeys() {
+		keys[k] = true
+	}
+	for _, k := range new.AllKeys() {
+		keys[k] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	for _, k := range sorted {
+
+		o, n := old.Get(k), new.Get(k)
+		if reflect.DeepEqual(o, n) {
+			continue
+		}
+
+		line := fmt.Sprintf("%s: %v -> %v", k, maskSecret(k, o), maskSecret(k, n))
+		if store && strings.HasPrefix(k, DSP_KEY) {
+			shadowed = append(shadowed, line)
+			continue
+		}
+
+		for _, prefix := range RESTART_KEYS {
+			if strings.HasPrefix(k, strings.ToLower(prefix)) {
+				line += " (restart required)"
+				break
+			}
+		}
+		changed = append(changed, line)
+	}
+
+	return
+}
+
+// value of secret key is hidden, empty value is kept
+func maskSecret(key string, v interface{}) interface{} {
+
+	if v == nil || fmt.Sprint(v) == "" {
+		return v
+	}
+
+	for _, secret := range SECRET_KEYS {
+		if strings.HasSuffix(key, strings.ToLower(secret)) {
+			return dsp.SECRET_MASK
+		}
+	}
+
+	return v
+}
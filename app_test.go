@@ -0,0 +1,163 @@
+package main
+
+import (
+	"airpush/auction"
+	"airpush/auction/event"
+	"airpush/auction/metrics"
+	"airpush/auction/notice"
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// viper of yaml config
+func testViper(t *testing.T, conf string) *viper.Viper {
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewBufferString(conf)); err != nil {
+		t.Fatalf("read config: %s", err)
+	}
+
+	return v
+}
+
+func TestDiffConfig(t *testing.T) {
+
+	old := testViper(t, `
+app:
+  server:
+    AdminToken: old-token
+  tracking:
+    secret: ""
+  auction:
+    timeout: 100
+    dsp:
+      node_1:
+        timeout: 80
+        price:
+          encryption_key: old-key
+`)
+	new := testViper(t, `
+app:
+  server:
+    AdminToken: new-token
+  tracking:
+    secret: new-secret-value-1234
+  auction:
+    timeout: 120
+    dsp:
+      node_1:
+        timeout: 90
+        price:
+          encryption_key: new-key
+`)
+
+	changed, shadowed := diffConfig(old, new, false)
+	text := strings.Join(changed, "\n")
+
+	for _, secret := range []string{"old-token", "new-token", "new-secret-value-1234", "old-key", "new-key"} {
+		if strings.Contains(text, secret) {
+			t.Fatalf("secret %q logged in %s", secret, text)
+		}
+	}
+
+	expected := []string{
+		"app.auction.dsp.node_1.price.encryption_key: ****** -> ******",
+		"app.auction.dsp.node_1.timeout: 80 -> 90",
+		"app.auction.timeout: 100 -> 120",
+		"app.server.admintoken: ****** -> ****** (restart required)",
+		"app.tracking.secret:  -> ****** (restart required)",
+	}
+	if text != strings.Join(expected, "\n") || len(shadowed) != 0 {
+		t.Fatalf("unexpected diff:\n%s\nshadowed: %v", text, shadowed)
+	}
+
+	// dsp settings of store file
+	changed, shadowed = diffConfig(old, new, true)
+	if len(changed) != 3 || len(shadowed) != 2 {
+		t.Fatalf("unexpected diff with store:\n%s\nshadowed: %v", strings.Join(changed, "\n"), shadowed)
+	}
+	for _, line := range shadowed {
+		if !strings.HasPrefix(line, DSP_KEY) {
+			t.Fatalf("not dsp key shadowed %s", line)
+		}
+	}
+}
+
+func TestFlushLog(t *testing.T) {
+
+	buf := new(bytes.Buffer)
+	logger := logrus.New()
+	logger.SetLevel(logrus.WarnLevel)
+	logger.SetOutput(&logWriter{w: bufio.NewWriterSize(buf, 1024)})
+
+	logger.Warnf("config reload: %s", "app.auction.timeout: 100 -> 200")
+	if buf.Len() != 0 {
+		t.Fatalf("log line written before flush")
+	}
+
+	flushLog(logger)
+	if !strings.Contains(buf.String(), "app.auction.timeout: 100 -> 200") {
+		t.Fatalf("log line not flushed: %q", buf.String())
+	}
+}
+
+// sink with fixed drop count
+type droppingSink struct {
+	dropped uint64
+}
+
+func (s *droppingSink) Write(e event.Event) bool {
+	return false
+}
+
+func (s *droppingSink) Close() error {
+	return nil
+}
+
+func (s *droppingSink) GetDropped() uint64 {
+	return s.dropped
+}
+
+func TestQueues(t *testing.T) {
+
+	a := &app{
+		metrics: metrics.New(),
+		notifier: notice.New(),
+		stats: auction.NewStats(),
+		events: &droppingSink{dropped: 3},
+	}
+	a.queues()
+
+	stats := a.stats.Get()
+	for key, want := range map[string]uint64{"notice.dropped": 0, "notice.failed": 0, "events.dropped": 3} {
+		if got, ok := stats[key]; !ok || got != want {
+			t.Errorf("stats %s = %d, want %d", key, got, want)
+		}
+	}
+	if _, ok := stats["events.failed"]; ok {
+		t.Error("events.failed in stats of sink without failures")
+	}
+
+	var buf bytes.Buffer
+	if err := a.metrics.Write(&buf); err != nil {
+		t.Fatalf("write metrics: %s", err)
+	}
+	for _, want := range []string{`queue_dropped_total{queue="events"} 3`, `queue_dropped_total{queue="notice"} 0`, `queue_failed_total{queue="notice"} 0`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("metrics miss %s", want)
+		}
+	}
+	if strings.Contains(buf.String(), `queue_failed_total{queue="events"}`) {
+		t.Error("failed counter of sink without failures")
+	}
+
+	// metrics disabled
+	a = &app{notifier: notice.New(), stats: auction.NewStats()}
+	a.queues()
+}
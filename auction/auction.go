@@ -2,20 +2,44 @@ package auction
 
 import (
 	"airpush/auction/bid"
+	"airpush/auction/breaker"
+	"airpush/auction/currency"
+	"airpush/auction/deal"
 	"airpush/auction/dsp"
+	"airpush/auction/event"
+	"airpush/auction/floor"
+	"airpush/auction/metrics"
+	"airpush/auction/notice"
+	"airpush/auction/openrtb"
+	"airpush/auction/quality"
+	"airpush/auction/tracking"
 	"airpush/auction/transaction"
+	"airpush/auction/vast"
+	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 )
 
+// auction types
+const TYPE_FIRST_PRICE = "first_price"
+const TYPE_SECOND_PRICE = "second_price"
+
 // settings setter
 type AuctionOption func(*Auction)
 
 // dsps list
-func SetDsp(dsp []*dsp.Dsp) AuctionOption {
+func SetDsp(list []*dsp.Dsp) AuctionOption {
+	return func(a *Auction) {
+		a.pool = dsp.NewPool(dsp.SetList(list))
+	}
+}
+
+// dsps pool changed at runtime
+func SetPool(pool *dsp.Pool) AuctionOption {
 	return func(a *Auction) {
-		a.dsp = dsp
+		a.pool = pool
 	}
 }
 
@@ -26,13 +50,113 @@ func SetTimeout(duration time.Duration) AuctionOption {
 	}
 }
 
+// floor rules
+func SetFloors(floors *floor.Floors) AuctionOption {
+	return func(a *Auction) {
+		a.floors = floors
+	}
+}
+
+// private marketplace deals
+func SetDeals(deals *deal.Deals) AuctionOption {
+	return func(a *Auction) {
+		a.deals = deals
+	}
+}
+
+// ad quality validation of offers
+func SetQuality(quality *quality.Quality) AuctionOption {
+	return func(a *Auction) {
+		a.quality = quality
+	}
+}
+
+// exchange rates, offers are ranked in exchange currency
+func SetRates(rates *currency.Rates) AuctionOption {
+	return func(a *Auction) {
+		a.rates = rates
+	}
+}
+
+// store of winning vast documents
+func SetVast(store *vast.Store) AuctionOption {
+	return func(a *Auction) {
+		a.vast = store
+	}
+}
+
+// prometheus metrics
+func SetMetrics(metrics *metrics.Metrics) AuctionOption {
+	return func(a *Auction) {
+		a.metrics = metrics
+	}
+}
+
+// counters shared with previous auction on config reload
+func SetStats(stats *Stats) AuctionOption {
+	return func(a *Auction) {
+		a.stats = stats
+	}
+}
+
+// win/loss notices
+func SetNotifier(notifier *notice.Notifier) AuctionOption {
+	return func(a *Auction) {
+		a.notifier = notifier
+	}
+}
+
+// auction records sink, nil for disable
+func SetEvents(events event.Sink) AuctionOption {
+	return func(a *Auction) {
+		a.events = events
+	}
+}
+
+// encrypted impression and click tracking of winners, nil for disable
+func SetTracker(tracker *tracking.Tracker) AuctionOption {
+	return func(a *Auction) {
+		a.tracker = tracker
+	}
+}
+
+// auction type first_price/second_price
+func SetType(t string) AuctionOption {
+	return func(a *Auction) {
+		a.aType = t
+	}
+}
+
+// price increment added to second price
+func SetIncrement(increment float64) AuctionOption {
+	return func(a *Auction) {
+		a.increment = increment
+	}
+}
+
 type Auction struct {
 	timeout time.Duration
-	dsp []*dsp.Dsp
+	aType string
+	increment float64
+	floors *floor.Floors
+	deals *deal.Deals
+	quality *quality.Quality
+	rates *currency.Rates
+	vast *vast.Store
+	metrics *metrics.Metrics
+	notifier *notice.Notifier
+	events event.Sink
+	tracker *tracking.Tracker
+	stats *Stats
+	pool *dsp.Pool
 }
 
 func New(opts ...AuctionOption) (proto *Auction) {
-	proto = &Auction{}
+	proto = &Auction{
+		aType: TYPE_FIRST_PRICE,
+		stats: NewStats(),
+		pool: dsp.NewPool(),
+	}
 
 	// set custom settings
 	for _, opt := range opts {
@@ -42,34 +166,350 @@ func New(opts ...AuctionOption) (proto *Auction) {
 	return
 }
 
-func (a *Auction) Do() (win *bid.Bid, err error) {
+// get global tx timeout
+func (a *Auction) GetTimeout() time.Duration {
+	return a.timeout
+}
+
+// get dsps
+func (a *Auction) GetDsp() []*dsp.Dsp {
+	return a.pool.Get()
+}
+
+// get dsps pool
+func (a *Auction) GetPool() *dsp.Pool {
+	return a.pool
+}
 
-	// formed bids
-	var rBids, nBids []*bid.Bid
+// get auction stats
+func (a *Auction) GetStats() *Stats {
+	return a.stats
+}
 
-	for _, d := range a.dsp {
-		rBids = append(rBids, bid.New(bid.SetDsp(d)))
-	}
+// run auction for incoming openrtb request, one auction per impression
+// dsp calls still in flight are canceled once auction decided
+func (a *Auction) Do(ctx context.Context, req *openrtb.BidRequest) (res *bid.AuctionResponse, err error) {
 
-	err = transaction.New(transaction.SetTimeout(a.timeout), transaction.SetBids(rBids)).Do()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// formed bids and skipped dsps
+	var rBids []*bid.Bid
+	var skipped []event.DspRecord
+
+	start := time.Now()
+	defer func() {
+		a.metrics.Auction(auctionResult(res, err), time.Since(start))
+		a.log(start, req, skipped, rBids, res, err)
+	}()
+
+	// request floors go out in exchange currency
+	err = a.normalize(req)
 	if err != nil {
 		return
 	}
 
-	// filter good bids
-	for _, b := range rBids {
-		if len(b.GetErr()) == 0 {
-			nBids = append(nBids, b)
+	// reserve prices go out as imp.bidfloor
+	if a.floors != nil {
+		a.floors.Apply(req)
+	}
+
+	// matched deals go out as imp.pmp.deals
+	if a.deals != nil {
+		a.deals.Apply(req)
+	}
+
+	// clearing type goes out as at
+	req.AT = a.at()
+
+	// dsps snapshot, pool changes apply to next auction
+	for _, d := range a.pool.Get() {
+
+		// filtered, throttled or dsp with open circuit breaker is skipped
+		generation, reason := d.Allow(req)
+		if reason != "" {
+			a.stats.Inc("skipped." + reason)
+			a.metrics.Skip(d.GetName(), reason)
+			if a.events != nil {
+				skipped = append(skipped, event.DspRecord{Name: d.GetName(), Status: event.STATUS_SKIPPED, Reason: reason})
+			}
+			continue
 		}
+
+		rBids = append(rBids, bid.New(bid.SetDsp(d), bid.SetRequest(req), bid.SetGeneration(generation)))
 	}
 
-	if len(nBids) > 0 {
-		// sort
-		sort.Sort(bid.OrderBids(nBids))
-		win = nBids[0]
-	} else {
+	err = transaction.New(transaction.SetTimeout(a.timeout), transaction.SetBids(rBids)).Do(ctx)
+
+	// feed breakers, every invited dsp must be recorded
+	for _, b := range rBids {
+		b.GetDsp().Record(b.GetGeneration(), breakerResult(b.GetStatus()))
+	}
+
+	if err != nil {
+		return
+	}
+
+	offers := a.collect(req, rBids)
+
+	res = &bid.AuctionResponse{
+		ID: req.ID,
+	}
+	if a.rates != nil {
+		res.Cur = a.rates.GetBase()
+	}
+
+	for i := range req.Imp {
+		res.Imp = append(res.Imp, a.decide(&req.Imp[i], offers[req.Imp[i].ID]))
+	}
+
+	a.notify(req, rBids, res)
+	a.track(req, res)
+	a.cache(req, res)
+
+	if !res.IsFilled() {
 		err = fmt.Errorf("empty auction")
 	}
 
 	return
-}
\ No newline at end of file
+}
+
+// valid offers grouped by impression id, rejects counted by reason
+func (a *Auction) collect(req *openrtb.BidRequest, bids []*bid.Bid) map[string][]*bid.RtbResponse {
+
+	offers := make(map[string][]*bid.RtbResponse, len(req.Imp))
+
+	for _, b := range bids {
+
+		a.stats.Inc(b.GetStatus())
+		a.metrics.Call(b.GetDsp().GetName(), b.GetStatus(), b.GetBuild())
+
+		for _, r := range b.GetRes() {
+
+			imp := req.GetImp(r.Bid.ImpID)
+			switch {
+			case imp == nil:
+				r.Reject(bid.REASON_UNKNOWN_IMP)
+			case !a.exchange(r):
+				r.Reject(bid.REASON_UNKNOWN_CURRENCY)
+			case r.Bid.DealID != "":
+				a.checkDeal(imp, r)
+			case imp.IsPrivate():
+				r.Reject(bid.REASON_PRIVATE_AUCTION)
+			case r.Value < imp.BidFloor:
+				r.Reject(bid.REASON_BELOW_FLOOR)
+			}
+
+			// ad quality of offer on known impression
+			if r.IsValid() && a.quality != nil {
+				if reason := a.quality.Check(req, imp, r); reason != "" {
+					r.Reject(reason)
+				}
+			}
+
+			a.metrics.Bid(r.Dsp, r.Value)
+
+			if !r.IsValid() {
+				a.stats.Inc("rejected." + r.Reason)
+				a.metrics.Reject(r.Dsp, r.Reason)
+				continue
+			}
+
+			offers[imp.ID] = append(offers[imp.ID], r)
+		}
+	}
+
+	return offers
+}
+
+// convert offer price to exchange currency, false when currency has no rate
+func (a *Auction) exchange(r *bid.RtbResponse) bool {
+
+	if a.rates == nil {
+		r.Value = r.Bid.Price
+		return true
+	}
+
+	value, ok := a.rates.ToBase(r.Bid.Price, r.Cur)
+	r.Value = value
+	return ok
+}
+
+// convert impression and deal floors of request to exchange currency
+func (a *Auction) normalize(req *openrtb.BidRequest) error {
+
+	if a.rates == nil {
+		return nil
+	}
+
+	base := a.rates.GetBase()
+	for i := range req.Imp {
+
+		imp := &req.Imp[i]
+		floor, ok := a.rates.ToBase(imp.BidFloor, imp.BidFloorCur)
+		if !ok {
+			return fmt.Errorf("imp %s floor currency %s has no rate", imp.ID, imp.BidFloorCur)
+		}
+		imp.BidFloor, imp.BidFloorCur = floor, base
+
+		if imp.PMP == nil {
+			continue
+		}
+
+		for j := range imp.PMP.Deals {
+			d := &imp.PMP.Deals[j]
+			floor, ok := a.rates.ToBase(d.BidFloor, d.BidFloorCur)
+			if !ok {
+				return fmt.Errorf("deal %s floor currency %s has no rate", d.ID, d.BidFloorCur)
+			}
+			d.BidFloor, d.BidFloorCur = floor, base
+		}
+	}
+
+	return nil
+}
+
+// validate offer on deal, valid offer get deal priority over open auction
+func (a *Auction) checkDeal(imp *openrtb.Imp, r *bid.RtbResponse) {
+
+	d := imp.GetDeal(r.Bid.DealID)
+	switch {
+	case d == nil:
+		r.Reject(bid.REASON_UNKNOWN_DEAL)
+		return
+	case r.Value < d.BidFloor:
+		r.Reject(bid.REASON_BELOW_DEAL_FLOOR)
+		return
+	case len(d.WSeat) > 0 && !hasSeat(d.WSeat, r.Seat):
+		r.Reject(bid.REASON_SEAT_BLOCKED)
+		return
+	}
+
+	r.Deal = d
+	r.Priority = 1
+	if a.deals != nil {
+		r.Priority += a.deals.Priority(d.ID)
+	}
+}
+
+// pick winner of single impression
+func (a *Auction) decide(imp *openrtb.Imp, offers []*bid.RtbResponse) (res bid.ImpResponse) {
+
+	res.ImpID = imp.ID
+
+	if len(offers) == 0 {
+		a.stats.Inc("imp.no_fill")
+		a.metrics.Imp(metrics.IMP_NO_FILL, 0)
+		return
+	}
+
+	sort.Sort(bid.OrderBids(offers))
+	offers = bestPerSeat(offers)
+	res.Win = offers[0]
+	res.Win.Price = a.clearingPrice(imp, offers)
+	a.stats.Inc("imp.win")
+	a.metrics.Imp(metrics.IMP_WIN, res.Win.Price)
+	a.metrics.Win(res.Win.Dsp)
+
+	if res.Win.IsDeal() {
+		res.Deal = res.Win.Deal.ID
+		a.stats.Inc("imp.deal")
+	}
+
+	return
+}
+
+// openrtb at of auction type
+func (a *Auction) at() int {
+	if a.aType == TYPE_SECOND_PRICE {
+		return openrtb.AUCTION_TYPE_SECOND_PRICE
+	}
+	return openrtb.AUCTION_TYPE_FIRST_PRICE
+}
+
+// first offer of every dsp seat from sorted offers
+// winner can not set own second price by runner-up of same seat
+func bestPerSeat(offers []*bid.RtbResponse) []*bid.RtbResponse {
+
+	res := make([]*bid.RtbResponse, 0, len(offers))
+	seen := make(map[[2]string]bool, len(offers))
+	for _, r := range offers {
+		key := [2]string{r.Dsp, r.Seat}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		res = append(res, r)
+	}
+
+	return res
+}
+
+// is seat in list
+func hasSeat(seats []string, seat string) bool {
+	for _, s := range seats {
+		if s == seat {
+			return true
+		}
+	}
+	return false
+}
+
+// keep winning vast of video impressions for vast endpoint
+func (a *Auction) cache(req *openrtb.BidRequest, res *bid.AuctionResponse) {
+
+	if a.vast == nil {
+		return
+	}
+
+	for _, imp := range res.Imp {
+
+		if imp.Win == nil || imp.Win.Bid.AdM == "" || req.GetImp(imp.ImpID).Video == nil {
+			continue
+		}
+
+		doc, err := vast.Normalize(imp.Win.Bid.AdM, imp.Win.Bid.AdID)
+		if err != nil {
+			continue
+		}
+
+		// wrapped tag url get exchange impression here
+		if a.tracker != nil && imp.Token != "" {
+			if u := a.tracker.URL(tracking.KIND_IMP, imp.Token); !strings.Contains(doc, u) {
+				doc = vast.Inject(doc, []string{u}, nil)
+			}
+		}
+
+		if !a.vast.Put(&vast.Ad{
+			Doc: doc,
+			AuctionID: req.ID,
+			ImpID: imp.ImpID,
+			Price: imp.Win.Price,
+			Currency: res.Cur,
+		}) {
+			a.stats.Inc("vast.dropped")
+		}
+	}
+}
+
+// metrics result of auction
+func auctionResult(res *bid.AuctionResponse, err error) string {
+	switch {
+	case err == nil:
+		return metrics.RESULT_FILLED
+	case res != nil:
+		return metrics.RESULT_EMPTY
+	}
+	return metrics.RESULT_ERROR
+}
+
+// breaker result of bid status, no bid is healthy answer
+func breakerResult(status string) int {
+	switch status {
+	case bid.STATUS_ERROR:
+		return breaker.RESULT_ERROR
+	case bid.STATUS_TIMEOUT, bid.STATUS_PENDING:
+		return breaker.RESULT_TIMEOUT
+	}
+	return breaker.RESULT_OK
+}
@@ -2,10 +2,40 @@ package bid
 
 import (
 	"airpush/auction/dsp"
+	"airpush/auction/openrtb"
+	"airpush/client"
+	"context"
+	"fmt"
 	"sync"
 	"time"
 )
 
+// bid states
+const STATUS_PENDING = "pending"
+const STATUS_OK = "ok"
+const STATUS_NO_BID = "no_bid"
+const STATUS_ERROR = "error"
+const STATUS_TIMEOUT = "timeout"
+
+// reject reasons
+const REASON_BELOW_FLOOR = "below_floor"
+const REASON_UNKNOWN_IMP = "unknown_imp"
+const REASON_UNKNOWN_DEAL = "unknown_deal"
+const REASON_BELOW_DEAL_FLOOR = "below_deal_floor"
+const REASON_SEAT_BLOCKED = "seat_blocked"
+const REASON_PRIVATE_AUCTION = "private_auction"
+const REASON_BLOCKED_ADV = "blocked_adv"
+const REASON_BLOCKED_APP = "blocked_app"
+const REASON_BLOCKED_CAT = "blocked_cat"
+const REASON_BLOCKED_ATTR = "blocked_attr"
+const REASON_BLOCKED_CREATIVE = "blocked_creative"
+const REASON_CURRENCY = "currency"
+const REASON_UNKNOWN_CURRENCY = "unknown_currency"
+const REASON_MISSING_MARKUP = "missing_markup"
+const REASON_INVALID_SIZE = "invalid_size"
+const REASON_INVALID_VAST = "invalid_vast"
+const REASON_INVALID_NATIVE = "invalid_native"
+
 // settings setter
 type BidOption func(*Bid)
 
@@ -16,18 +46,41 @@ func SetDsp(d *dsp.Dsp) BidOption {
 	}
 }
 
+// SetRequest
+func SetRequest(req *openrtb.BidRequest) BidOption {
+	return func(t *Bid) {
+		t.req = req
+	}
+}
+
+// breaker generation call was allowed in
+func SetGeneration(generation uint64) BidOption {
+	return func(t *Bid) {
+		t.generation = generation
+	}
+}
+
 // Bid
+// single dsp call, may carry many offers on request impressions
 type Bid struct {
 	mu sync.Mutex
 	dsp *dsp.Dsp
-	res *RtbResponse
+	req *openrtb.BidRequest
+	res []*RtbResponse
+	status string
+	build time.Duration
+	start time.Time
 	err []string
+	generation uint64
 }
 
 // Bid.New()
 func New(opts ...BidOption) (proto *Bid) {
 
-	proto = &Bid{}
+	proto = &Bid{
+		status: STATUS_PENDING,
+		start: time.Now(),
+	}
 
 	// set custom settings
 	for _, opt := range opts {
@@ -37,42 +90,140 @@ func New(opts ...BidOption) (proto *Bid) {
 }
 
 // execute bid request
-func (b *Bid) Do() {
+func (b *Bid) Do(ctx context.Context) {
 
-	startTime := time.Now()
-	b.res = &RtbResponse{
-		Dsp: b.dsp.GetName(),
-	}
+	res, status, err := b.exec(ctx)
 
 	// calc request time
-	defer func() {
-		b.res.Build = time.Since(startTime).String()
-	}()
+	build := time.Since(b.start)
+	for _, r := range res {
+		r.Build = build.String()
+	}
 
-	buf, err := b.dsp.GetClient().Do()
+	b.finish(status, res, build, err)
+}
+
+// request dsp and collect offers
+func (b *Bid) exec(ctx context.Context) ([]*RtbResponse, string, error) {
+
+	body, err := b.req.MarshalJSON()
 	if err != nil {
-		b.err = append(b.err, err.Error())
+		return nil, STATUS_ERROR, err
+	}
+
+	buf, err := b.dsp.GetClient().Do(ctx, body)
+	if err == client.ErrTimeout {
+		return nil, STATUS_TIMEOUT, err
+	}
+	if err != nil {
+		return nil, STATUS_ERROR, err
+	}
+
+	// http 204 or empty body
+	if len(buf) == 0 {
+		return nil, STATUS_NO_BID, nil
+	}
+
+	rtb := new(openrtb.BidResponse)
+	err = rtb.UnmarshalJSON(buf)
+	if err != nil {
+		return nil, STATUS_ERROR, err
+	}
+
+	if rtb.ID != b.req.ID {
+		return nil, STATUS_ERROR, fmt.Errorf("response id %s not match request id %s", rtb.ID, b.req.ID)
+	}
+
+	if !rtb.HasBids() {
+		return nil, STATUS_NO_BID, nil
+	}
+
+	var res []*RtbResponse
+	for _, s := range rtb.SeatBid {
+		for _, rb := range s.Bid {
+			res = append(res, &RtbResponse{
+				Dsp: b.dsp.GetName(),
+				Seat: s.Seat,
+				BidID: rtb.BidID,
+				Cur: rtb.Cur,
+				Bid: rb,
+			})
+		}
+	}
+
+	return res, STATUS_OK, nil
+}
+
+// store result, ignored when bid already expired
+func (b *Bid) finish(status string, res []*RtbResponse, build time.Duration, err error) {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	if b.status != STATUS_PENDING {
 		return
 	}
 
-	res := new(BidResponse)
-	err = res.UnmarshalJSON(buf)
+	b.status = status
+	b.res = res
+	b.build = build
 	if err != nil {
 		b.err = append(b.err, err.Error())
+	}
+}
+
+// mark pending bid as timed out, late response will be dropped
+func (b *Bid) Expire() {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	if b.status != STATUS_PENDING {
 		return
 	}
 
-	b.res.Bid = *res
+	b.status = STATUS_TIMEOUT
+	b.build = time.Since(b.start)
+	b.err = append(b.err, "bid timeout")
+}
+
+// get dsp
+func (b *Bid) GetDsp() *dsp.Dsp {
+	return b.dsp
 }
 
-// get bid response
-func (b *Bid) GetRes() *RtbResponse {
+// get dsp offers
+func (b *Bid) GetRes() []*RtbResponse {
 	defer b.mu.Unlock()
 	b.mu.Lock()
 
 	return b.res
 }
 
+// get bid request
+func (b *Bid) GetReq() *openrtb.BidRequest {
+	return b.req
+}
+
+// get bid status
+func (b *Bid) GetStatus() string {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	return b.status
+}
+
+// get dsp call duration
+func (b *Bid) GetBuild() time.Duration {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	return b.build
+}
+
+// get breaker generation of call
+func (b *Bid) GetGeneration() uint64 {
+	return b.generation
+}
+
 // get bid errors
 func (b *Bid) GetErr() []string {
 	defer b.mu.Unlock()
@@ -81,11 +232,14 @@ func (b *Bid) GetErr() []string {
 	return b.err
 }
 
-// order bids by cpm
-type OrderBids []*Bid
+// order offers by deal priority, then by price in exchange currency
+type OrderBids []*RtbResponse
 
 func (a OrderBids) Len() int      { return len(a) }
 func (a OrderBids) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 func (a OrderBids) Less(i, j int) bool {
-	return a[i].GetRes().Bid.Cpm > a[j].GetRes().Bid.Cpm
+	if a[i].Priority != a[j].Priority {
+		return a[i].Priority > a[j].Priority
+	}
+	return a[i].Value > a[j].Value
 }
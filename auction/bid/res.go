@@ -1,12 +1,60 @@
 package bid
 
+import "airpush/auction/openrtb"
+
+// single dsp offer on impression
 type RtbResponse struct {
 	Dsp string `json:"dsp"`
 	Build string `json:"time_req"`
-	Bid BidResponse
+	Seat string `json:"seat,omitempty"`
+	Price float64 `json:"price"`
+	Bid openrtb.Bid `json:"bid"`
+	BidID string `json:"-"`
+	Cur string `json:"-"`
+	Value float64 `json:"-"`
+	Reason string `json:"-"`
+	Deal *openrtb.Deal `json:"-"`
+	Priority int `json:"-"`
+}
+
+// result of impression auction, win is nil on no fill
+type ImpResponse struct {
+	ImpID string `json:"impid"`
+	Win *RtbResponse `json:"win,omitempty"`
+	Deal string `json:"deal,omitempty"`
+	Token string `json:"-"`
+}
+
+// auction result for every request impression
+type AuctionResponse struct {
+	ID string `json:"id"`
+	Imp []ImpResponse `json:"imp"`
+	Cur string `json:"cur,omitempty"`
+}
+
+// is offer on deal
+func (r *RtbResponse) IsDeal() bool {
+	return r.Deal != nil
+}
+
+// reject offer with reason
+func (r *RtbResponse) Reject(reason string) {
+	if r.Reason == "" {
+		r.Reason = reason
+	}
 }
 
-type BidResponse struct {
-	Wait string `json:"time_wait"`
-	Cpm float64 `json:"cpm"`
-}
\ No newline at end of file
+// is offer not rejected
+func (r *RtbResponse) IsValid() bool {
+	return r.Reason == ""
+}
+
+// is any impression filled
+func (r *AuctionResponse) IsFilled() bool {
+	for _, imp := range r.Imp {
+		if imp.Win != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -28,7 +28,7 @@ func easyjsonFda9a428DecodeAirpushAuctionBid(in *jlexer.Lexer, out *RtbResponse)
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		if in.IsNull() {
 			in.Skip()
@@ -40,7 +40,11 @@ func easyjsonFda9a428DecodeAirpushAuctionBid(in *jlexer.Lexer, out *RtbResponse)
 			out.Dsp = string(in.String())
 		case "time_req":
 			out.Build = string(in.String())
-		case "Bid":
+		case "seat":
+			out.Seat = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "bid":
 			(out.Bid).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
@@ -58,32 +62,27 @@ func easyjsonFda9a428EncodeAirpushAuctionBid(out *jwriter.Writer, in RtbResponse
 	_ = first
 	{
 		const prefix string = ",\"dsp\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
+		out.RawString(prefix[1:])
 		out.String(string(in.Dsp))
 	}
 	{
 		const prefix string = ",\"time_req\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
+		out.RawString(prefix)
 		out.String(string(in.Build))
 	}
+	if in.Seat != "" {
+		const prefix string = ",\"seat\":"
+		out.RawString(prefix)
+		out.String(string(in.Seat))
+	}
 	{
-		const prefix string = ",\"Bid\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	{
+		const prefix string = ",\"bid\":"
+		out.RawString(prefix)
 		(in.Bid).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
@@ -112,7 +111,7 @@ func (v *RtbResponse) UnmarshalJSON(data []byte) error {
 func (v *RtbResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonFda9a428DecodeAirpushAuctionBid(l, v)
 }
-func easyjsonFda9a428DecodeAirpushAuctionBid1(in *jlexer.Lexer, out *BidResponse) {
+func easyjsonFda9a428DecodeAirpushAuctionBid1(in *jlexer.Lexer, out *ImpResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -123,7 +122,7 @@ func easyjsonFda9a428DecodeAirpushAuctionBid1(in *jlexer.Lexer, out *BidResponse
 	}
 	in.Delim('{')
 	for !in.IsDelim('}') {
-		key := in.UnsafeString()
+		key := in.UnsafeFieldName(false)
 		in.WantColon()
 		if in.IsNull() {
 			in.Skip()
@@ -131,10 +130,20 @@ func easyjsonFda9a428DecodeAirpushAuctionBid1(in *jlexer.Lexer, out *BidResponse
 			continue
 		}
 		switch key {
-		case "time_wait":
-			out.Wait = string(in.String())
-		case "cpm":
-			out.Cpm = float64(in.Float64())
+		case "impid":
+			out.ImpID = string(in.String())
+		case "win":
+			if in.IsNull() {
+				in.Skip()
+				out.Win = nil
+			} else {
+				if out.Win == nil {
+					out.Win = new(RtbResponse)
+				}
+				(*out.Win).UnmarshalEasyJSON(in)
+			}
+		case "deal":
+			out.Deal = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -145,53 +154,160 @@ func easyjsonFda9a428DecodeAirpushAuctionBid1(in *jlexer.Lexer, out *BidResponse
 		in.Consumed()
 	}
 }
-func easyjsonFda9a428EncodeAirpushAuctionBid1(out *jwriter.Writer, in BidResponse) {
+func easyjsonFda9a428EncodeAirpushAuctionBid1(out *jwriter.Writer, in ImpResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"time_wait\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.String(string(in.Wait))
+		const prefix string = ",\"impid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ImpID))
 	}
-	{
-		const prefix string = ",\"cpm\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		out.Float64(float64(in.Cpm))
+	if in.Win != nil {
+		const prefix string = ",\"win\":"
+		out.RawString(prefix)
+		(*in.Win).MarshalEasyJSON(out)
+	}
+	if in.Deal != "" {
+		const prefix string = ",\"deal\":"
+		out.RawString(prefix)
+		out.String(string(in.Deal))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v BidResponse) MarshalJSON() ([]byte, error) {
+func (v ImpResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonFda9a428EncodeAirpushAuctionBid1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v BidResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ImpResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonFda9a428EncodeAirpushAuctionBid1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *BidResponse) UnmarshalJSON(data []byte) error {
+func (v *ImpResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonFda9a428DecodeAirpushAuctionBid1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *BidResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ImpResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonFda9a428DecodeAirpushAuctionBid1(l, v)
 }
+func easyjsonFda9a428DecodeAirpushAuctionBid2(in *jlexer.Lexer, out *AuctionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "imp":
+			if in.IsNull() {
+				in.Skip()
+				out.Imp = nil
+			} else {
+				in.Delim('[')
+				if out.Imp == nil {
+					if !in.IsDelim(']') {
+						out.Imp = make([]ImpResponse, 0, 1)
+					} else {
+						out.Imp = []ImpResponse{}
+					}
+				} else {
+					out.Imp = (out.Imp)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ImpResponse
+					(v1).UnmarshalEasyJSON(in)
+					out.Imp = append(out.Imp, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "cur":
+			out.Cur = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonFda9a428EncodeAirpushAuctionBid2(out *jwriter.Writer, in AuctionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"imp\":"
+		out.RawString(prefix)
+		if in.Imp == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Imp {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Cur != "" {
+		const prefix string = ",\"cur\":"
+		out.RawString(prefix)
+		out.String(string(in.Cur))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuctionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonFda9a428EncodeAirpushAuctionBid2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuctionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonFda9a428EncodeAirpushAuctionBid2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuctionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonFda9a428DecodeAirpushAuctionBid2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuctionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonFda9a428DecodeAirpushAuctionBid2(l, v)
+}
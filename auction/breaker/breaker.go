@@ -0,0 +1,273 @@
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker states
+const STATE_CLOSED = "closed"
+const STATE_OPEN = "open"
+const STATE_HALF_OPEN = "half_open"
+
+// call results
+const RESULT_OK = 0
+const RESULT_ERROR = 1
+const RESULT_TIMEOUT = 2
+
+// defaults
+const DEFAULT_WINDOW = 100
+const DEFAULT_MIN_REQUESTS = 20
+const DEFAULT_COOLDOWN = time.Duration(5000) * time.Millisecond
+const DEFAULT_PROBES = 5
+
+// settings setter
+type BreakerOption func(*Breaker)
+
+// number of last calls rates are calculated on
+func SetWindow(n int) BreakerOption {
+	return func(b *Breaker) {
+		if n > 0 {
+			b.window = make([]int, n)
+		}
+	}
+}
+
+// calls needed in window before breaker may open
+func SetMinRequests(n int) BreakerOption {
+	return func(b *Breaker) {
+		if n > 0 {
+			b.minRequests = n
+		}
+	}
+}
+
+// error rate 0..1 opening breaker, zero disable
+func SetErrorRate(rate float64) BreakerOption {
+	return func(b *Breaker) {
+		b.errorRate = rate
+	}
+}
+
+// timeout rate 0..1 opening breaker, zero disable
+func SetTimeoutRate(rate float64) BreakerOption {
+	return func(b *Breaker) {
+		b.timeoutRate = rate
+	}
+}
+
+// time breaker stay open before probe calls
+func SetCooldown(duration time.Duration) BreakerOption {
+	return func(b *Breaker) {
+		if duration > 0 {
+			b.cooldown = duration
+		}
+	}
+}
+
+// probe calls in half open state
+func SetProbes(n int) BreakerOption {
+	return func(b *Breaker) {
+		if n > 0 {
+			b.probes = n
+		}
+	}
+}
+
+// State snapshot of breaker
+type State struct {
+	State    string    `json:"state"`
+	Requests int       `json:"requests"`
+	Errors   int       `json:"errors"`
+	Timeouts int       `json:"timeouts"`
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+}
+
+// Breaker circuit breaker closed/open/half-open
+type Breaker struct {
+	mu sync.Mutex
+	state string
+
+	// ring of last results
+	window []int
+	pos int
+	count int
+	errors int
+	timeouts int
+
+	minRequests int
+	errorRate float64
+	timeoutRate float64
+	cooldown time.Duration
+	probes int
+
+	openedAt time.Time
+	inFlight int
+	passed int
+
+	// changed on every state switch, results of older calls are stale
+	generation uint64
+}
+
+// init breaker
+func New(opts ...BreakerOption) (proto *Breaker) {
+
+	proto = &Breaker{
+		state: STATE_CLOSED,
+		window: make([]int, DEFAULT_WINDOW),
+		minRequests: DEFAULT_MIN_REQUESTS,
+		cooldown: DEFAULT_COOLDOWN,
+		probes: DEFAULT_PROBES,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// is call allowed and generation of call
+// allowed call must be followed by Record with returned generation
+func (b *Breaker) Allow() (uint64, bool) {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	switch b.state {
+	case STATE_OPEN:
+		if time.Since(b.openedAt) < b.cooldown {
+			return b.generation, false
+		}
+		b.state = STATE_HALF_OPEN
+		b.generation++
+		b.inFlight = 0
+		b.passed = 0
+		fallthrough
+	case STATE_HALF_OPEN:
+		if b.inFlight >= b.probes {
+			return b.generation, false
+		}
+		b.inFlight++
+	}
+
+	return b.generation, true
+}
+
+// register call result, result of call allowed before last state switch is ignored
+func (b *Breaker) Record(generation uint64, result int) {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case STATE_HALF_OPEN:
+		b.inFlight--
+		if result != RESULT_OK {
+			b.open()
+			return
+		}
+		b.passed++
+		if b.passed >= b.probes {
+			b.reset()
+		}
+	case STATE_CLOSED:
+		b.push(result)
+		if b.tripped() {
+			b.open()
+		}
+	}
+}
+
+// allowed call was not made, probe is given back
+func (b *Breaker) Release(generation uint64) {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	if generation == b.generation && b.state == STATE_HALF_OPEN {
+		b.inFlight--
+	}
+}
+
+// current state
+func (b *Breaker) GetState() State {
+	defer b.mu.Unlock()
+	b.mu.Lock()
+
+	return State{
+		State: b.state,
+		Requests: b.count,
+		Errors: b.errors,
+		Timeouts: b.timeouts,
+		OpenedAt: b.openedAt,
+	}
+}
+
+// add result into window
+func (b *Breaker) push(result int) {
+
+	if b.count == len(b.window) {
+		b.forget(b.window[b.pos])
+	} else {
+		b.count++
+	}
+
+	b.window[b.pos] = result
+	b.pos = (b.pos + 1) % len(b.window)
+
+	switch result {
+	case RESULT_ERROR:
+		b.errors++
+	case RESULT_TIMEOUT:
+		b.timeouts++
+	}
+}
+
+// drop result leaving window
+func (b *Breaker) forget(result int) {
+	switch result {
+	case RESULT_ERROR:
+		b.errors--
+	case RESULT_TIMEOUT:
+		b.timeouts--
+	}
+}
+
+// is rates over thresholds
+func (b *Breaker) tripped() bool {
+
+	if b.count < b.minRequests {
+		return false
+	}
+
+	if b.errorRate > 0 && float64(b.errors) / float64(b.count) >= b.errorRate {
+		return true
+	}
+
+	if b.timeoutRate > 0 && float64(b.timeouts) / float64(b.count) >= b.timeoutRate {
+		return true
+	}
+
+	return false
+}
+
+// switch to open
+func (b *Breaker) open() {
+	b.state = STATE_OPEN
+	b.generation++
+	b.openedAt = time.Now()
+}
+
+// switch to closed with clean window
+func (b *Breaker) reset() {
+	b.state = STATE_CLOSED
+	b.generation++
+	b.pos = 0
+	b.count = 0
+	b.errors = 0
+	b.timeouts = 0
+	b.openedAt = time.Time{}
+}
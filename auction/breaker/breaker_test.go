@@ -0,0 +1,147 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+)
+
+// breaker opened by failed calls, waiting cooldown
+func opened(t *testing.T, b *Breaker, calls int) {
+
+	for i := 0; i < calls; i++ {
+		generation, ok := b.Allow()
+		if !ok {
+			t.Fatalf("call %d not allowed", i)
+		}
+		b.Record(generation, RESULT_ERROR)
+	}
+
+	if state := b.GetState().State; state != STATE_OPEN {
+		t.Fatalf("state %s, want %s", state, STATE_OPEN)
+	}
+}
+
+func TestTripped(t *testing.T) {
+
+	cases := []struct {
+		name string
+		opts []BreakerOption
+		results []int
+		state string
+	}{
+		{"below min requests", []BreakerOption{SetMinRequests(5), SetErrorRate(0.5)},
+			[]int{RESULT_ERROR, RESULT_ERROR, RESULT_ERROR, RESULT_ERROR}, STATE_CLOSED},
+		{"error rate reached", []BreakerOption{SetMinRequests(4), SetErrorRate(0.5)},
+			[]int{RESULT_OK, RESULT_ERROR, RESULT_OK, RESULT_ERROR}, STATE_OPEN},
+		{"error rate not reached", []BreakerOption{SetMinRequests(4), SetErrorRate(0.5)},
+			[]int{RESULT_OK, RESULT_ERROR, RESULT_OK, RESULT_OK}, STATE_CLOSED},
+		{"timeout rate reached", []BreakerOption{SetMinRequests(2), SetTimeoutRate(0.5)},
+			[]int{RESULT_OK, RESULT_TIMEOUT}, STATE_OPEN},
+		{"timeouts not counted as errors", []BreakerOption{SetMinRequests(2), SetErrorRate(0.5)},
+			[]int{RESULT_TIMEOUT, RESULT_TIMEOUT}, STATE_CLOSED},
+		{"old results leave window", []BreakerOption{SetWindow(2), SetMinRequests(2), SetErrorRate(1)},
+			[]int{RESULT_OK, RESULT_ERROR, RESULT_ERROR}, STATE_OPEN},
+		{"zero rates disable", []BreakerOption{SetMinRequests(1)},
+			[]int{RESULT_ERROR, RESULT_TIMEOUT}, STATE_CLOSED},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			b := New(c.opts...)
+			for _, result := range c.results {
+				generation, ok := b.Allow()
+				if !ok {
+					t.Fatalf("call not allowed in state %s", b.GetState().State)
+				}
+				b.Record(generation, result)
+			}
+
+			if state := b.GetState().State; state != c.state {
+				t.Fatalf("state %s, want %s", state, c.state)
+			}
+		})
+	}
+}
+
+func TestHalfOpen(t *testing.T) {
+
+	b := New(SetMinRequests(1), SetErrorRate(1), SetCooldown(time.Millisecond), SetProbes(2))
+	opened(t, b, 1)
+
+	if _, ok := b.Allow(); ok {
+		t.Fatalf("call allowed during cooldown")
+	}
+
+	time.Sleep(2 * time.Millisecond)
+
+	// probes limited while in flight
+	g1, ok1 := b.Allow()
+	g2, ok2 := b.Allow()
+	if _, ok := b.Allow(); !ok1 || !ok2 || ok {
+		t.Fatalf("probes allowed %t %t %t, want 2", ok1, ok2, ok)
+	}
+
+	b.Record(g1, RESULT_OK)
+	if state := b.GetState().State; state != STATE_HALF_OPEN {
+		t.Fatalf("state %s, want %s", state, STATE_HALF_OPEN)
+	}
+
+	b.Record(g2, RESULT_OK)
+	if state := b.GetState().State; state != STATE_CLOSED {
+		t.Fatalf("state %s, want %s", state, STATE_CLOSED)
+	}
+}
+
+func TestHalfOpenFailedProbe(t *testing.T) {
+
+	b := New(SetMinRequests(1), SetErrorRate(1), SetCooldown(time.Millisecond))
+	opened(t, b, 1)
+	time.Sleep(2 * time.Millisecond)
+
+	generation, ok := b.Allow()
+	if !ok {
+		t.Fatalf("probe not allowed")
+	}
+
+	b.Record(generation, RESULT_TIMEOUT)
+	if state := b.GetState().State; state != STATE_OPEN {
+		t.Fatalf("state %s, want %s", state, STATE_OPEN)
+	}
+}
+
+func TestStaleResult(t *testing.T) {
+
+	b := New(SetMinRequests(2), SetErrorRate(1), SetCooldown(time.Millisecond), SetProbes(1))
+
+	// slow call allowed while closed
+	slow, ok := b.Allow()
+	if !ok {
+		t.Fatalf("call not allowed")
+	}
+
+	opened(t, b, 2)
+	time.Sleep(2 * time.Millisecond)
+
+	probe, ok := b.Allow()
+	if !ok {
+		t.Fatalf("probe not allowed")
+	}
+
+	// late closed call is not counted as probe
+	b.Record(slow, RESULT_OK)
+	if state := b.GetState().State; state != STATE_HALF_OPEN {
+		t.Fatalf("state %s after stale result, want %s", state, STATE_HALF_OPEN)
+	}
+
+	b.Record(probe, RESULT_OK)
+	if state := b.GetState().State; state != STATE_CLOSED {
+		t.Fatalf("state %s, want %s", state, STATE_CLOSED)
+	}
+
+	// late failed closed call does not count in new window
+	b.Record(slow, RESULT_ERROR)
+	if s := b.GetState(); s.Requests != 0 || s.Errors != 0 {
+		t.Fatalf("stale result recorded %+v", s)
+	}
+}
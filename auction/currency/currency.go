@@ -0,0 +1,208 @@
+package currency
+
+import (
+	"airpush/auction/openrtb"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// logger interface
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// rates file model, units of currency for one unit of exchange currency
+type config struct {
+	Rates map[string]float64 `yaml:"rates"`
+}
+
+// settings setter
+type RatesOption func(*Rates)
+
+// exchange currency, every price is ranked in it
+func SetBase(base string) RatesOption {
+	return func(r *Rates) {
+		if base != "" {
+			r.base = strings.ToUpper(base)
+		}
+	}
+}
+
+// rates file
+func SetPath(path string) RatesOption {
+	return func(r *Rates) {
+		r.path = path
+	}
+}
+
+// reload period of rates file, zero disable reload
+func SetRefresh(duration time.Duration) RatesOption {
+	return func(r *Rates) {
+		r.refresh = duration
+	}
+}
+
+// logger
+func SetLogger(logger Logger) RatesOption {
+	return func(r *Rates) {
+		r.logger = logger
+	}
+}
+
+// Rates exchange rate table
+type Rates struct {
+	mu sync.RWMutex
+	base string
+	rates map[string]float64
+	path string
+	refresh time.Duration
+	done chan struct{}
+	logger Logger
+}
+
+// init rates
+func New(opts ...RatesOption) (proto *Rates) {
+
+	proto = &Rates{
+		base: openrtb.DEFAULT_CURRENCY,
+		rates: make(map[string]float64),
+		done: make(chan struct{}),
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// load rates file
+func (r *Rates) Load() error {
+
+	if r.path == "" {
+		return nil
+	}
+
+	buf, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+
+	c := config{}
+	err = yaml.Unmarshal(buf, &c)
+	if err != nil {
+		return err
+	}
+
+	r.Set(c.Rates)
+
+	return nil
+}
+
+// reload rates file periodically, last good table is kept on error
+func (r *Rates) Start() {
+
+	if r.path == "" || r.refresh <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.refresh)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.Load(); err != nil && r.logger != nil {
+					r.logger.Printf("reload rates %s err: %s", r.path, err)
+				}
+			case <-r.done:
+				return
+			}
+		}
+	}()
+}
+
+// stop reload
+func (r *Rates) Close() {
+	close(r.done)
+}
+
+// replace rates, invalid rates are skipped
+func (r *Rates) Set(rates map[string]float64) {
+
+	table := make(map[string]float64, len(rates))
+	for cur, rate := range rates {
+		if rate > 0 {
+			table[strings.ToUpper(cur)] = rate
+		}
+	}
+
+	defer r.mu.Unlock()
+	r.mu.Lock()
+
+	r.rates = table
+}
+
+// get rates
+func (r *Rates) Get() map[string]float64 {
+	defer r.mu.RUnlock()
+	r.mu.RLock()
+
+	res := make(map[string]float64, len(r.rates))
+	for cur, rate := range r.rates {
+		res[cur] = rate
+	}
+	return res
+}
+
+// get exchange currency
+func (r *Rates) GetBase() string {
+	return r.base
+}
+
+// convert price in currency to exchange currency, empty currency is openrtb default
+func (r *Rates) ToBase(price float64, cur string) (float64, bool) {
+
+	rate, ok := r.rate(cur)
+	if !ok {
+		return 0, false
+	}
+
+	return price / rate, true
+}
+
+// convert price in exchange currency to currency
+func (r *Rates) FromBase(price float64, cur string) (float64, bool) {
+
+	rate, ok := r.rate(cur)
+	if !ok {
+		return 0, false
+	}
+
+	return price * rate, true
+}
+
+// units of currency for one unit of exchange currency
+func (r *Rates) rate(cur string) (float64, bool) {
+
+	if cur == "" {
+		cur = openrtb.DEFAULT_CURRENCY
+	}
+
+	cur = strings.ToUpper(cur)
+	if cur == r.base {
+		return 1, true
+	}
+
+	defer r.mu.RUnlock()
+	r.mu.RLock()
+
+	rate, ok := r.rates[cur]
+	return rate, ok
+}
@@ -0,0 +1,58 @@
+package currency
+
+import (
+	"testing"
+)
+
+func TestToBase(t *testing.T) {
+
+	r := New(SetBase("USD"))
+	r.Set(map[string]float64{"EUR": 0.5, "jpy": 100, "BAD": 0, "NEG": -1})
+
+	cases := []struct {
+		price float64
+		cur string
+		value float64
+		ok bool
+	}{
+		{2, "USD", 2, true},
+		{2, "", 2, true},
+		{2, "EUR", 4, true},
+		{2, "eur", 4, true},
+		{150, "JPY", 1.5, true},
+		{2, "GBP", 0, false},
+		{2, "BAD", 0, false},
+		{2, "NEG", 0, false},
+	}
+
+	for _, c := range cases {
+		value, ok := r.ToBase(c.price, c.cur)
+		if value != c.value || ok != c.ok {
+			t.Fatalf("%v %s to base = %v %t, want %v %t", c.price, c.cur, value, ok, c.value, c.ok)
+		}
+	}
+}
+
+func TestFromBase(t *testing.T) {
+
+	r := New(SetBase("USD"))
+	r.Set(map[string]float64{"EUR": 0.5})
+
+	cases := []struct {
+		price float64
+		cur string
+		value float64
+		ok bool
+	}{
+		{4, "USD", 4, true},
+		{4, "EUR", 2, true},
+		{4, "GBP", 0, false},
+	}
+
+	for _, c := range cases {
+		value, ok := r.FromBase(c.price, c.cur)
+		if value != c.value || ok != c.ok {
+			t.Fatalf("%v from base to %s = %v %t, want %v %t", c.price, c.cur, value, ok, c.value, c.ok)
+		}
+	}
+}
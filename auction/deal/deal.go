@@ -0,0 +1,205 @@
+package deal
+
+import (
+	"airpush/auction/openrtb"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Deal private marketplace agreement
+// attached to matched impressions, empty targeting field match any value
+type Deal struct {
+	ID        string   `json:"id" yaml:"id"`
+	Floor     float64  `json:"floor" yaml:"floor"`
+	AT        int      `json:"at,omitempty" yaml:"at"`
+	Seats     []string `json:"seats,omitempty" yaml:"seats"`
+	Priority  int      `json:"priority,omitempty" yaml:"priority"`
+	Private   bool     `json:"private,omitempty" yaml:"private"`
+	Publisher string   `json:"publisher,omitempty" yaml:"publisher"`
+	Placement string   `json:"placement,omitempty" yaml:"placement"`
+	Format    string   `json:"format,omitempty" yaml:"format"`
+}
+
+// deals file model
+type config struct {
+	Deals []Deal `yaml:"deals"`
+}
+
+// settings setter
+type DealsOption func(*Deals)
+
+// initial deals
+func SetDeals(deals []Deal) DealsOption {
+	return func(d *Deals) {
+		d.deals = deals
+	}
+}
+
+// currency of deal floors
+func SetCur(cur string) DealsOption {
+	return func(d *Deals) {
+		d.cur = cur
+	}
+}
+
+// Deals registry
+type Deals struct {
+	mu    sync.RWMutex
+	deals []Deal
+	cur   string
+}
+
+// init registry
+func New(opts ...DealsOption) (proto *Deals) {
+
+	proto = &Deals{}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// load deals from yaml file
+func (d *Deals) Load(path string) error {
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	c := config{}
+	err = yaml.Unmarshal(buf, &c)
+	if err != nil {
+		return err
+	}
+
+	err = Check(c.Deals)
+	if err != nil {
+		return err
+	}
+
+	d.Set(c.Deals)
+
+	return nil
+}
+
+// deals are valid, id must be unique, floor finite and not negative
+// at is empty or known auction type, priority not negative so open auction stay last
+func Check(deals []Deal) error {
+
+	ids := make(map[string]bool, len(deals))
+	for i, deal := range deals {
+
+		if deal.ID == "" {
+			return fmt.Errorf("deal %d: id is empty", i)
+		}
+
+		if ids[deal.ID] {
+			return fmt.Errorf("deal %s: duplicate id", deal.ID)
+		}
+		ids[deal.ID] = true
+
+		if math.IsNaN(deal.Floor) || math.IsInf(deal.Floor, 0) || deal.Floor < 0 {
+			return fmt.Errorf("deal %s: floor must be finite and not negative, got %v", deal.ID, deal.Floor)
+		}
+
+		switch deal.AT {
+		case 0, openrtb.AUCTION_TYPE_FIRST_PRICE, openrtb.AUCTION_TYPE_SECOND_PRICE, openrtb.AUCTION_TYPE_FIXED_PRICE:
+		default:
+			return fmt.Errorf("deal %s: unknown auction type %d", deal.ID, deal.AT)
+		}
+
+		if deal.Priority < 0 {
+			return fmt.Errorf("deal %s: priority must not be negative, got %d", deal.ID, deal.Priority)
+		}
+	}
+
+	return nil
+}
+
+// replace deals
+func (d *Deals) Set(deals []Deal) {
+	defer d.mu.Unlock()
+	d.mu.Lock()
+
+	d.deals = deals
+}
+
+// get deals
+func (d *Deals) Get() []Deal {
+	defer d.mu.RUnlock()
+	d.mu.RLock()
+
+	return d.deals
+}
+
+// priority of deal, unknown deal has lowest
+func (d *Deals) Priority(id string) int {
+	defer d.mu.RUnlock()
+	d.mu.RLock()
+
+	for _, deal := range d.deals {
+		if deal.ID == id {
+			return deal.Priority
+		}
+	}
+	return 0
+}
+
+// attach matched deals to imp.pmp.deals, deals sent by publisher are kept
+func (d *Deals) Apply(req *openrtb.BidRequest) {
+	defer d.mu.RUnlock()
+	d.mu.RLock()
+
+	for i := range req.Imp {
+
+		imp := &req.Imp[i]
+		for _, deal := range d.deals {
+
+			if !deal.match(req, imp) || imp.GetDeal(deal.ID) != nil {
+				continue
+			}
+
+			if imp.PMP == nil {
+				imp.PMP = &openrtb.PMP{}
+			}
+
+			imp.PMP.Deals = append(imp.PMP.Deals, openrtb.Deal{
+				ID: deal.ID,
+				BidFloor: deal.Floor,
+				BidFloorCur: d.cur,
+				AT: deal.AT,
+				WSeat: deal.Seats,
+			})
+
+			if deal.Private {
+				imp.PMP.PrivateAuction = 1
+			}
+		}
+	}
+}
+
+// is deal targeted on impression
+func (deal *Deal) match(req *openrtb.BidRequest, imp *openrtb.Imp) bool {
+
+	if deal.Publisher != "" && deal.Publisher != req.GetPublisherID() {
+		return false
+	}
+
+	if deal.Placement != "" && deal.Placement != imp.TagID {
+		return false
+	}
+
+	if deal.Format != "" && !imp.HasFormat(deal.Format) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,100 @@
+package deal
+
+import (
+	"airpush/auction/openrtb"
+	"math"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+
+	d := New(SetCur("USD"), SetDeals([]Deal{
+		{ID: "open", Floor: 1, Priority: 1},
+		{ID: "pub", Floor: 2, Publisher: "p1", Seats: []string{"s1"}, Priority: 2},
+		{ID: "video", Floor: 3, Format: openrtb.FORMAT_VIDEO},
+		{ID: "private", Floor: 4, Placement: "top", Private: true, AT: openrtb.AUCTION_TYPE_FIXED_PRICE},
+	}))
+
+	req := &openrtb.BidRequest{
+		Site: &openrtb.Site{Publisher: &openrtb.Publisher{ID: "p1"}},
+		Imp: []openrtb.Imp{
+			{ID: "1", TagID: "top", Banner: &openrtb.Banner{}, PMP: &openrtb.PMP{Deals: []openrtb.Deal{{ID: "open", BidFloor: 0.5}}}},
+			{ID: "2", TagID: "bottom", Video: &openrtb.Video{}},
+		},
+	}
+	d.Apply(req)
+
+	cases := []struct {
+		imp int
+		deals []string
+		private int
+	}{
+		{0, []string{"open", "pub", "private"}, 1},
+		{1, []string{"open", "pub", "video"}, 0},
+	}
+
+	for _, c := range cases {
+
+		imp := req.Imp[c.imp]
+		if len(imp.PMP.Deals) != len(c.deals) || imp.PMP.PrivateAuction != c.private {
+			t.Fatalf("imp %s deals %+v private %d", imp.ID, imp.PMP.Deals, imp.PMP.PrivateAuction)
+		}
+
+		for i, id := range c.deals {
+			if imp.PMP.Deals[i].ID != id {
+				t.Fatalf("imp %s deal %d is %s, want %s", imp.ID, i, imp.PMP.Deals[i].ID, id)
+			}
+		}
+	}
+
+	// deal sent by publisher is kept
+	if open := req.Imp[0].GetDeal("open"); open.BidFloor != 0.5 || open.BidFloorCur != "" {
+		t.Fatalf("publisher deal replaced %+v", open)
+	}
+
+	if pub := req.Imp[0].GetDeal("pub"); pub.BidFloor != 2 || pub.BidFloorCur != "USD" || len(pub.WSeat) != 1 {
+		t.Fatalf("unexpected deal %+v", pub)
+	}
+
+	if private := req.Imp[0].GetDeal("private"); private.AT != openrtb.AUCTION_TYPE_FIXED_PRICE {
+		t.Fatalf("unexpected deal %+v", private)
+	}
+}
+
+func TestPriority(t *testing.T) {
+
+	d := New(SetDeals([]Deal{{ID: "a", Priority: 2}, {ID: "b"}}))
+
+	cases := map[string]int{"a": 2, "b": 0, "unknown": 0}
+	for id, priority := range cases {
+		if got := d.Priority(id); got != priority {
+			t.Fatalf("deal %s priority %d, want %d", id, got, priority)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+
+	cases := []struct {
+		name string
+		deals []Deal
+		valid bool
+	}{
+		{"valid", []Deal{{ID: "a", Floor: 1, AT: openrtb.AUCTION_TYPE_FIXED_PRICE, Priority: 2}, {ID: "b"}}, true},
+		{"empty list", nil, true},
+		{"empty id", []Deal{{ID: "a"}, {Floor: 1}}, false},
+		{"duplicate id", []Deal{{ID: "a", Floor: 1}, {ID: "a", Floor: 2}}, false},
+		{"negative floor", []Deal{{ID: "a", Floor: -0.5}}, false},
+		{"nan floor", []Deal{{ID: "a", Floor: math.NaN()}}, false},
+		{"infinite floor", []Deal{{ID: "a", Floor: math.Inf(1)}}, false},
+		{"unknown auction type", []Deal{{ID: "a", AT: 4}}, false},
+		{"negative auction type", []Deal{{ID: "a", AT: -1}}, false},
+		{"negative priority", []Deal{{ID: "a", Priority: -1}}, false},
+	}
+
+	for _, c := range cases {
+		if err := Check(c.deals); (err == nil) != c.valid {
+			t.Fatalf("%s: valid %t, error %v", c.name, c.valid, err)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package dsp
+
+import (
+	"airpush/auction/breaker"
+	"airpush/auction/filter"
+	"airpush/auction/price"
+	"airpush/auction/throttle"
+	"airpush/client"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// Config settings dsp is built from, timeouts in millisecond
+type Config struct {
+	Name     string          `json:"name" yaml:"-" mapstructure:"-"`
+	Type     string          `json:"type" yaml:"type" mapstructure:"type"`
+	Addr     string          `json:"addr" yaml:"addr" mapstructure:"addr"`
+	Timeout  int             `json:"timeout" yaml:"timeout" mapstructure:"timeout"`
+	Paused   bool            `json:"paused,omitempty" yaml:"paused,omitempty" mapstructure:"paused"`
+	Filter   *filter.Filter  `json:"filter,omitempty" yaml:"filter,omitempty" mapstructure:"filter"`
+	Breaker  *BreakerConfig  `json:"breaker,omitempty" yaml:"breaker,omitempty" mapstructure:"breaker"`
+	Throttle *ThrottleConfig `json:"throttle,omitempty" yaml:"throttle,omitempty" mapstructure:"throttle"`
+	Price    *PriceConfig    `json:"price,omitempty" yaml:"price,omitempty" mapstructure:"price"`
+}
+
+// value of secret shown by admin api and logs
+const SECRET_MASK = "******"
+
+// PriceConfig keys of encrypted ${AUCTION_PRICE}, base64 or hex, both required when enabled
+type PriceConfig struct {
+	Enabled       bool   `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
+	EncryptionKey string `json:"encryption_key" yaml:"encryption_key" mapstructure:"encryption_key"`
+	IntegrityKey  string `json:"integrity_key" yaml:"integrity_key" mapstructure:"integrity_key"`
+}
+
+// BreakerConfig circuit breaker settings, cooldown in millisecond
+type BreakerConfig struct {
+	Window      int     `json:"window,omitempty" yaml:"window,omitempty" mapstructure:"window"`
+	MinRequests int     `json:"min_requests,omitempty" yaml:"min_requests,omitempty" mapstructure:"min_requests"`
+	ErrorRate   float64 `json:"error_rate,omitempty" yaml:"error_rate,omitempty" mapstructure:"error_rate"`
+	TimeoutRate float64 `json:"timeout_rate,omitempty" yaml:"timeout_rate,omitempty" mapstructure:"timeout_rate"`
+	Cooldown    int     `json:"cooldown,omitempty" yaml:"cooldown,omitempty" mapstructure:"cooldown"`
+	Probes      int     `json:"probes,omitempty" yaml:"probes,omitempty" mapstructure:"probes"`
+}
+
+// ThrottleConfig qps cap and sampling settings, empty sample invite to every auction
+type ThrottleConfig struct {
+	QPS    float64 `json:"qps,omitempty" yaml:"qps,omitempty" mapstructure:"qps"`
+	Burst  int     `json:"burst,omitempty" yaml:"burst,omitempty" mapstructure:"burst"`
+	Sample *int    `json:"sample,omitempty" yaml:"sample,omitempty" mapstructure:"sample"`
+}
+
+// validate settings needed to build dsp, first problem is reported
+func (c *Config) Validate() error {
+
+	if res := c.Check(); len(res) > 0 {
+		return res[0]
+	}
+
+	return nil
+}
+
+// all problems of dsp settings
+func (c *Config) Check() (res []error) {
+
+	if c.Name == "" {
+		return []error{fmt.Errorf("dsp name is empty")}
+	}
+
+	add := func(format string, args ...interface{}) {
+		res = append(res, fmt.Errorf("dsp %s: "+format, append([]interface{}{c.Name}, args...)...))
+	}
+
+	switch c.Type {
+	case client.CONN_TYPE_HTTP:
+		if u, err := url.Parse(c.Addr); c.Addr == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("addr %q is not http(s) url", c.Addr)
+		}
+	case client.CONN_TYPE_GRPC:
+		if _, _, err := net.SplitHostPort(c.Addr); err != nil {
+			add("addr %q is not host:port", c.Addr)
+		}
+	default:
+		add("unknown type %q, expected %s or %s", c.Type, client.CONN_TYPE_HTTP, client.CONN_TYPE_GRPC)
+	}
+
+	if c.Timeout <= 0 {
+		add("timeout must be positive, got %d", c.Timeout)
+	}
+
+	if b := c.Breaker; b != nil {
+		if b.Window < 0 || b.MinRequests < 0 || b.Cooldown < 0 || b.Probes < 0 {
+			add("breaker window, min_requests, cooldown and probes must not be negative")
+		}
+		if b.ErrorRate < 0 || b.ErrorRate > 1 {
+			add("breaker error_rate %v is out of 0..1", b.ErrorRate)
+		}
+		if b.TimeoutRate < 0 || b.TimeoutRate > 1 {
+			add("breaker timeout_rate %v is out of 0..1", b.TimeoutRate)
+		}
+	}
+
+	if t := c.Throttle; t != nil {
+		if t.QPS < 0 || t.Burst < 0 {
+			add("throttle qps and burst must not be negative")
+		}
+		if t.Sample != nil && (*t.Sample < 0 || *t.Sample > 100) {
+			add("throttle sample %d is out of 0..100", *t.Sample)
+		}
+	}
+
+	if p := c.Price; p != nil && p.Enabled {
+		if _, err := price.DecodeKey(p.EncryptionKey); err != nil {
+			add("price is enabled, encryption_key %s", err)
+		}
+		if _, err := price.DecodeKey(p.IntegrityKey); err != nil {
+			add("price is enabled, integrity_key %s", err)
+		}
+	}
+
+	if c.Filter != nil {
+		for _, err := range c.Filter.Check() {
+			add("filter %s", err)
+		}
+	}
+
+	return
+}
+
+// build dsp with own client from settings
+func Build(c Config) (*Dsp, error) {
+
+	err := c.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := client.New(
+		client.SetAddr(c.Addr),
+		client.SetConnectionType(c.Type),
+		client.WithTimeout(time.Duration(c.Timeout) * time.Millisecond),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("init client %s err: %s", c.Name, err)
+	}
+
+	var opts []DspOption
+
+	// circuit breaker
+	if b := c.Breaker; b != nil {
+		opts = append(opts, SetBreaker(breaker.New(
+			breaker.SetWindow(b.Window),
+			breaker.SetMinRequests(b.MinRequests),
+			breaker.SetErrorRate(b.ErrorRate),
+			breaker.SetTimeoutRate(b.TimeoutRate),
+			breaker.SetCooldown(time.Duration(b.Cooldown) * time.Millisecond),
+			breaker.SetProbes(b.Probes),
+		)))
+	}
+
+	// targeting
+	if c.Filter != nil {
+		opts = append(opts, SetFilter(c.Filter))
+	}
+
+	// qps cap and traffic sampling
+	if t := c.Throttle; t != nil {
+		topts := []throttle.ThrottleOption{
+			throttle.SetQPS(t.QPS),
+			throttle.SetBurst(t.Burst),
+		}
+		if t.Sample != nil {
+			topts = append(topts, throttle.SetSample(*t.Sample))
+		}
+		opts = append(opts, SetThrottle(throttle.New(topts...)))
+	}
+
+	// encrypted price macro
+	if p := c.Price; p != nil && p.Enabled {
+		ekey, _ := price.DecodeKey(p.EncryptionKey)
+		ikey, _ := price.DecodeKey(p.IntegrityKey)
+		crypter, err := price.New(price.SetEncryptionKey(ekey), price.SetIntegrityKey(ikey))
+		if err != nil {
+			return nil, fmt.Errorf("init price crypter %s err: %s", c.Name, err)
+		}
+		opts = append(opts, SetCrypter(crypter))
+	}
+
+	opts = append(opts, SetPaused(c.Paused), setConfig(c))
+
+	return New(c.Name, cl, opts...), nil
+}
+
+// settings with price keys hidden, empty key is kept
+func (c Config) Masked() Config {
+
+	if c.Price != nil {
+		p := *c.Price
+		p.EncryptionKey = mask(p.EncryptionKey)
+		p.IntegrityKey = mask(p.IntegrityKey)
+		c.Price = &p
+	}
+
+	return c
+}
+
+// masked price keys sent back by admin api are replaced by keys of current settings
+func (c *Config) Unmask(current Config) {
+
+	if c.Price == nil || current.Price == nil {
+		return
+	}
+
+	p := *c.Price
+	if p.EncryptionKey == SECRET_MASK {
+		p.EncryptionKey = current.Price.EncryptionKey
+	}
+	if p.IntegrityKey == SECRET_MASK {
+		p.IntegrityKey = current.Price.IntegrityKey
+	}
+	c.Price = &p
+}
+
+func mask(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return SECRET_MASK
+}
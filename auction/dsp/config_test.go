@@ -0,0 +1,95 @@
+package dsp
+
+import (
+	"testing"
+)
+
+// keys of doubleclick price decryption guide
+const TEST_ENCRYPTION_KEY = "skU7Ax_NL5pPAFyKdkfZjZz2-VhIN8bjj1rVFOaJ_5o="
+const TEST_INTEGRITY_KEY = "arO23ykdNqUQ5LEoQ0FVmPkBd7xB5CO89PDZlSjpFxo="
+
+func TestPriceCheck(t *testing.T) {
+
+	cases := []struct {
+		name string
+		price *PriceConfig
+		problems int
+		crypter bool
+	}{
+		{"no price", nil, 0, false},
+		{"disabled without keys", &PriceConfig{}, 0, false},
+		{"disabled with keys", &PriceConfig{EncryptionKey: TEST_ENCRYPTION_KEY, IntegrityKey: TEST_INTEGRITY_KEY}, 0, false},
+		{"enabled with keys", &PriceConfig{Enabled: true, EncryptionKey: TEST_ENCRYPTION_KEY, IntegrityKey: TEST_INTEGRITY_KEY}, 0, true},
+		{"enabled without keys", &PriceConfig{Enabled: true}, 2, false},
+		{"enabled without integrity key", &PriceConfig{Enabled: true, EncryptionKey: TEST_ENCRYPTION_KEY}, 1, false},
+		{"enabled with invalid key", &PriceConfig{Enabled: true, EncryptionKey: "not a key!", IntegrityKey: TEST_INTEGRITY_KEY}, 1, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			conf := testConfig("a")
+			conf.Price = c.price
+
+			if problems := conf.Check(); len(problems) != c.problems {
+				t.Fatalf("problems %v, want %d", problems, c.problems)
+			}
+
+			if c.problems > 0 {
+				return
+			}
+
+			d, err := Build(conf)
+			if err != nil {
+				t.Fatalf("build: %s", err)
+			}
+			defer d.GetClient().Close()
+
+			if (d.GetCrypter() != nil) != c.crypter {
+				t.Fatalf("crypter %t, want %t", d.GetCrypter() != nil, c.crypter)
+			}
+		})
+	}
+}
+
+func TestMasked(t *testing.T) {
+
+	conf := testConfig("a")
+	conf.Price = &PriceConfig{Enabled: true, EncryptionKey: TEST_ENCRYPTION_KEY}
+
+	masked := conf.Masked()
+	if p := masked.Price; p.EncryptionKey != SECRET_MASK || p.IntegrityKey != "" || !p.Enabled {
+		t.Fatalf("unexpected masked price %+v", p)
+	}
+
+	// settings of dsp are not changed
+	if conf.Price.EncryptionKey != TEST_ENCRYPTION_KEY {
+		t.Fatalf("masked settings share price keys")
+	}
+
+	if empty := testConfig("a").Masked(); empty.Price != nil {
+		t.Fatalf("price added to masked settings %+v", empty.Price)
+	}
+}
+
+func TestUnmask(t *testing.T) {
+
+	current := testConfig("a")
+	current.Price = &PriceConfig{Enabled: true, EncryptionKey: TEST_ENCRYPTION_KEY, IntegrityKey: TEST_INTEGRITY_KEY}
+
+	update := current.Masked()
+	update.Price.IntegrityKey = "new"
+	update.Unmask(current)
+
+	if p := update.Price; p.EncryptionKey != TEST_ENCRYPTION_KEY || p.IntegrityKey != "new" {
+		t.Fatalf("unexpected unmasked price %+v", p)
+	}
+
+	// new price settings of dsp without keys stay masked and fail check
+	fresh := testConfig("a")
+	fresh.Price = &PriceConfig{Enabled: true, EncryptionKey: SECRET_MASK, IntegrityKey: SECRET_MASK}
+	fresh.Unmask(testConfig("a"))
+	if len(fresh.Check()) != 2 {
+		t.Fatalf("masked keys of dsp without keys accepted")
+	}
+}
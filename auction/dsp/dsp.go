@@ -1,17 +1,91 @@
 package dsp
 
-import "airpush/client"
+import (
+	"airpush/auction/breaker"
+	"airpush/auction/filter"
+	"airpush/auction/openrtb"
+	"airpush/auction/price"
+	"airpush/auction/throttle"
+	"airpush/client"
+	"sync/atomic"
+	"time"
+)
+
+// skip reasons
+const SKIP_PAUSED = "paused"
+const SKIP_FILTERED = "filtered"
+const SKIP_SAMPLED = "sampled"
+const SKIP_QPS_LIMIT = "qps_limit"
+const SKIP_CIRCUIT_OPEN = "circuit_open"
+
+// settings setter
+type DspOption func(*Dsp)
+
+// targeting of dsp
+func SetFilter(f *filter.Filter) DspOption {
+	return func(d *Dsp) {
+		d.filter = f
+	}
+}
+
+// qps cap and traffic sampling of dsp
+func SetThrottle(t *throttle.Throttle) DspOption {
+	return func(d *Dsp) {
+		d.throttle = t
+	}
+}
+
+// circuit breaker of dsp
+func SetBreaker(b *breaker.Breaker) DspOption {
+	return func(d *Dsp) {
+		d.breaker = b
+	}
+}
+
+// paused dsp is not invited to auctions
+func SetPaused(paused bool) DspOption {
+	return func(d *Dsp) {
+		d.Pause(paused)
+	}
+}
+
+// crypter of ${AUCTION_PRICE}, nil send price in clear text
+func SetCrypter(crypter *price.Crypter) DspOption {
+	return func(d *Dsp) {
+		d.crypter = crypter
+	}
+}
+
+// settings dsp was built from
+func setConfig(c Config) DspOption {
+	return func(d *Dsp) {
+		d.config = c
+	}
+}
 
 type Dsp struct {
 	name string
 	client *client.Client
+	breaker *breaker.Breaker
+	throttle *throttle.Throttle
+	filter *filter.Filter
+	paused int32
+	crypter *price.Crypter
+	config Config
 }
 
-func New(name string, client *client.Client) *Dsp {
-	return &Dsp{
+func New(name string, client *client.Client, opts ...DspOption) *Dsp {
+	proto := &Dsp{
 		client: client,
 		name: name,
 	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return proto
 }
 
 func (dsp *Dsp) GetClient() *client.Client {
@@ -20,4 +94,79 @@ func (dsp *Dsp) GetClient() *client.Client {
 
 func (dsp *Dsp) GetName() string {
 	return dsp.name
-}
\ No newline at end of file
+}
+
+func (dsp *Dsp) GetFilter() *filter.Filter {
+	return dsp.filter
+}
+
+func (dsp *Dsp) GetBreaker() *breaker.Breaker {
+	return dsp.breaker
+}
+
+func (dsp *Dsp) GetCrypter() *price.Crypter {
+	return dsp.crypter
+}
+
+// settings of dsp with current pause state
+func (dsp *Dsp) GetConfig() Config {
+	c := dsp.config
+	c.Name = dsp.name
+	c.Paused = dsp.IsPaused()
+	return c
+}
+
+// pause or resume dsp
+func (dsp *Dsp) Pause(paused bool) {
+	var v int32
+	if paused {
+		v = 1
+	}
+	atomic.StoreInt32(&dsp.paused, v)
+}
+
+// is dsp paused
+func (dsp *Dsp) IsPaused() bool {
+	return atomic.LoadInt32(&dsp.paused) == 1
+}
+
+// is dsp invited to auction, return breaker generation of call and skip reason or empty string
+func (dsp *Dsp) Allow(req *openrtb.BidRequest) (uint64, string) {
+
+	if dsp.IsPaused() {
+		return 0, SKIP_PAUSED
+	}
+
+	if dsp.filter != nil && !dsp.filter.Match(req, time.Now()) {
+		return 0, SKIP_FILTERED
+	}
+
+	if dsp.throttle != nil && !dsp.throttle.Sampled() {
+		return 0, SKIP_SAMPLED
+	}
+
+	// breaker before qps, call skipped by open breaker does not take token
+	var generation uint64
+	if dsp.breaker != nil {
+		var ok bool
+		if generation, ok = dsp.breaker.Allow(); !ok {
+			return 0, SKIP_CIRCUIT_OPEN
+		}
+	}
+
+	if dsp.throttle != nil && !dsp.throttle.Take() {
+		if dsp.breaker != nil {
+			dsp.breaker.Release(generation)
+		}
+		return 0, SKIP_QPS_LIMIT
+	}
+
+	return generation, ""
+}
+
+// register result of dsp call allowed in breaker generation
+func (dsp *Dsp) Record(generation uint64, result int) {
+	if dsp.breaker != nil {
+		dsp.breaker.Record(generation, result)
+	}
+}
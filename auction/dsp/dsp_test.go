@@ -0,0 +1,55 @@
+package dsp
+
+import (
+	"airpush/auction/breaker"
+	"airpush/auction/openrtb"
+	"airpush/auction/throttle"
+	"testing"
+	"time"
+)
+
+func TestAllowOpenBreakerKeepToken(t *testing.T) {
+
+	b := breaker.New(breaker.SetMinRequests(1), breaker.SetErrorRate(1), breaker.SetCooldown(time.Hour))
+	th := throttle.New(throttle.SetQPS(0.001), throttle.SetBurst(1))
+	d := New("dsp", nil, SetBreaker(b), SetThrottle(th))
+
+	generation, reason := d.Allow(&openrtb.BidRequest{})
+	if reason != "" {
+		t.Fatalf("first call skipped %s", reason)
+	}
+	d.Record(generation, breaker.RESULT_ERROR)
+
+	// open breaker skip before token is taken
+	if _, reason := d.Allow(&openrtb.BidRequest{}); reason != SKIP_CIRCUIT_OPEN {
+		t.Fatalf("skip reason %q, want %s", reason, SKIP_CIRCUIT_OPEN)
+	}
+
+	if th.Take() {
+		t.Fatalf("token of first call not taken")
+	}
+}
+
+func TestAllowQpsLimitReleaseProbe(t *testing.T) {
+
+	b := breaker.New(breaker.SetMinRequests(1), breaker.SetErrorRate(1), breaker.SetCooldown(time.Millisecond), breaker.SetProbes(1))
+	th := throttle.New(throttle.SetQPS(0.001), throttle.SetBurst(1))
+	d := New("dsp", nil, SetBreaker(b))
+
+	generation, _ := d.Allow(&openrtb.BidRequest{})
+	d.Record(generation, breaker.RESULT_ERROR)
+	time.Sleep(2 * time.Millisecond)
+
+	// probe allowed by breaker, skipped by qps
+	th.Take()
+	d.throttle = th
+	if _, reason := d.Allow(&openrtb.BidRequest{}); reason != SKIP_QPS_LIMIT {
+		t.Fatalf("skip reason %q, want %s", reason, SKIP_QPS_LIMIT)
+	}
+
+	// probe slot given back
+	d.throttle = nil
+	if _, reason := d.Allow(&openrtb.BidRequest{}); reason != "" {
+		t.Fatalf("probe skipped %s", reason)
+	}
+}
@@ -0,0 +1,369 @@
+package dsp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// time replaced dsp client stay open for auctions in flight
+const DRAIN_TIMEOUT = time.Duration(5000) * time.Millisecond
+
+// settings change is not applied, store file is not written
+var ErrPersist = fmt.Errorf("persist dsp settings")
+
+// store file model
+type store struct {
+	Dsp map[string]Config `yaml:"dsp"`
+}
+
+// settings setter
+type PoolOption func(*Pool)
+
+// initial dsps
+func SetList(list []*Dsp) PoolOption {
+	return func(p *Pool) {
+		p.list.Store(list)
+	}
+}
+
+// file dsp settings are persisted to on every change, empty disable persist
+func SetPath(path string) PoolOption {
+	return func(p *Pool) {
+		p.path = path
+	}
+}
+
+// Pool dsps invited to auctions
+// readers get immutable snapshot, changes are applied by swap of whole list
+type Pool struct {
+	mu sync.Mutex
+	list atomic.Value
+	path string
+}
+
+// init pool
+func NewPool(opts ...PoolOption) (proto *Pool) {
+
+	proto = &Pool{}
+	proto.list.Store([]*Dsp(nil))
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// load dsp settings from store file
+func Load(path string) ([]Config, error) {
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := store{}
+	err = yaml.Unmarshal(buf, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	return Configs(s.Dsp), nil
+}
+
+// settings list of named settings, ordered by name
+func Configs(m map[string]Config) []Config {
+
+	res := make([]Config, 0, len(m))
+	for name, c := range m {
+		c.Name = name
+		res = append(res, c)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res
+}
+
+// snapshot of dsps
+func (p *Pool) Get() []*Dsp {
+	return p.list.Load().([]*Dsp)
+}
+
+// find dsp by name
+func (p *Pool) Find(name string) *Dsp {
+	for _, d := range p.Get() {
+		if d.GetName() == name {
+			return d
+		}
+	}
+	return nil
+}
+
+// add new dsp
+func (p *Pool) Create(c Config) error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	if p.Find(c.Name) != nil {
+		return fmt.Errorf("dsp %s already exists", c.Name)
+	}
+
+	d, err := Build(c)
+	if err != nil {
+		return err
+	}
+
+	list := append(p.copy(), d)
+	err = p.save(list)
+	if err != nil {
+		_ = d.GetClient().Close()
+		return err
+	}
+	p.list.Store(list)
+
+	return nil
+}
+
+// replace dsp settings, dsp is rebuilt with new client
+func (p *Pool) Update(c Config) error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	old := p.Find(c.Name)
+	if old == nil {
+		return fmt.Errorf("dsp %s not found", c.Name)
+	}
+
+	d, err := Build(c)
+	if err != nil {
+		return err
+	}
+
+	list := p.copy()
+	for i := range list {
+		if list[i] == old {
+			list[i] = d
+		}
+	}
+
+	err = p.save(list)
+	if err != nil {
+		_ = d.GetClient().Close()
+		return err
+	}
+	p.list.Store(list)
+	drain(old)
+
+	return nil
+}
+
+// remove dsp
+func (p *Pool) Delete(name string) error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	old := p.Find(name)
+	if old == nil {
+		return fmt.Errorf("dsp %s not found", name)
+	}
+
+	var list []*Dsp
+	for _, d := range p.Get() {
+		if d != old {
+			list = append(list, d)
+		}
+	}
+
+	err := p.save(list)
+	if err != nil {
+		return err
+	}
+	p.list.Store(list)
+	drain(old)
+
+	return nil
+}
+
+// pause or resume dsp, paused dsp keep client and breaker state
+func (p *Pool) Pause(name string, paused bool) error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	d := p.Find(name)
+	if d == nil {
+		return fmt.Errorf("dsp %s not found", name)
+	}
+
+	// pause state is persisted with settings, restored when store is not written
+	was := d.IsPaused()
+	d.Pause(paused)
+
+	err := p.save(p.Get())
+	if err != nil {
+		d.Pause(was)
+		return err
+	}
+
+	return nil
+}
+
+// apply full settings list, unchanged dsps keep client and breaker state
+// every settings is validated before any change
+func (p *Pool) Sync(configs []Config) error {
+	defer p.mu.Unlock()
+	p.mu.Lock()
+
+	for i := range configs {
+		if err := configs[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	current := make(map[string]*Dsp)
+	for _, d := range p.Get() {
+		current[d.GetName()] = d
+	}
+
+	var list, built, replaced []*Dsp
+	for _, c := range configs {
+
+		old := current[c.Name]
+		delete(current, c.Name)
+
+		if old != nil && reflect.DeepEqual(old.config, c) {
+			list = append(list, old)
+			continue
+		}
+
+		d, err := Build(c)
+		if err != nil {
+			for _, b := range built {
+				_ = b.GetClient().Close()
+			}
+			return err
+		}
+		list = append(list, d)
+		built = append(built, d)
+
+		if old != nil {
+			replaced = append(replaced, old)
+		}
+	}
+
+	// replaced and removed dsps
+	for _, old := range current {
+		replaced = append(replaced, old)
+	}
+
+	err := p.save(list)
+	if err != nil {
+		for _, b := range built {
+			_ = b.GetClient().Close()
+		}
+		return err
+	}
+
+	p.list.Store(list)
+	for _, old := range replaced {
+		drain(old)
+	}
+
+	return nil
+}
+
+// settings of every dsp
+func (p *Pool) GetConfig() []Config {
+
+	list := p.Get()
+	res := make([]Config, 0, len(list))
+	for _, d := range list {
+		res = append(res, d.GetConfig())
+	}
+
+	return res
+}
+
+// close clients of every dsp
+func (p *Pool) Close() {
+	for _, d := range p.Get() {
+		_ = d.GetClient().Close()
+	}
+}
+
+// copy of current list
+func (p *Pool) copy() []*Dsp {
+	list := p.Get()
+	return append(make([]*Dsp, 0, len(list) + 1), list...)
+}
+
+// persist settings to store file
+// written to temp file and renamed, store is never left half written
+func (p *Pool) save(list []*Dsp) error {
+
+	if p.path == "" {
+		return nil
+	}
+
+	s := store{Dsp: make(map[string]Config, len(list))}
+	for _, d := range list {
+		s.Dsp[d.GetName()] = d.GetConfig()
+	}
+
+	buf, err := yaml.Marshal(&s)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPersist, err)
+	}
+
+	err = writeFile(p.path, buf)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrPersist, err)
+	}
+
+	return nil
+}
+
+// replace file by temp file in same dir
+func writeFile(path string, buf []byte) error {
+
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+
+	_, err = f.Write(buf)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, 0600) // price keys of dsp inside
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+	}
+
+	return err
+}
+
+// close client of replaced dsp once auctions in flight are done
+func drain(d *Dsp) {
+	time.AfterFunc(DRAIN_TIMEOUT, func() {
+		_ = d.GetClient().Close()
+	})
+}
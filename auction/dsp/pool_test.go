@@ -0,0 +1,91 @@
+package dsp
+
+import (
+	"airpush/client"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// valid http dsp settings
+func testConfig(name string) Config {
+	return Config{Name: name, Type: client.CONN_TYPE_HTTP, Addr: "http://127.0.0.1:1/bid", Timeout: 10}
+}
+
+func TestPoolPersist(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "dsp.yaml")
+	p := NewPool(SetPath(path))
+	defer p.Close()
+
+	for _, name := range []string{"a", "b"} {
+		if err := p.Create(testConfig(name)); err != nil {
+			t.Fatalf("create %s: %s", name, err)
+		}
+	}
+
+	if err := p.Delete("a"); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+
+	configs, err := Load(path)
+	if err != nil {
+		t.Fatalf("load: %s", err)
+	}
+	if len(configs) != 1 || configs[0].Name != "b" {
+		t.Fatalf("unexpected store %+v", configs)
+	}
+
+	// only store file, temp files are renamed
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 1 {
+		t.Fatalf("unexpected files in store dir %d", len(files))
+	}
+
+	// price keys inside, readable by owner only
+	if mode := files[0].Mode().Perm(); mode != 0600 {
+		t.Fatalf("store file mode %o, want 600", mode)
+	}
+}
+
+func TestPoolPersistFail(t *testing.T) {
+
+	p := NewPool(SetPath(filepath.Join(os.TempDir(), "missing-pool-dir", "dsp.yaml")))
+	defer p.Close()
+
+	err := p.Create(testConfig("a"))
+	if !errors.Is(err, ErrPersist) {
+		t.Fatalf("create error %v, want %v", err, ErrPersist)
+	}
+
+	if len(p.Get()) != 0 {
+		t.Fatalf("not persisted dsp is added")
+	}
+}
+
+func TestPoolPauseRestoredOnPersistFail(t *testing.T) {
+
+	d, err := Build(testConfig("a"))
+	if err != nil {
+		t.Fatalf("build: %s", err)
+	}
+
+	p := NewPool(SetList([]*Dsp{d}), SetPath(filepath.Join(os.TempDir(), "missing-pool-dir", "dsp.yaml")))
+	defer p.Close()
+
+	if err := p.Pause("a", true); !errors.Is(err, ErrPersist) {
+		t.Fatalf("pause error %v, want %v", err, ErrPersist)
+	}
+
+	if d.IsPaused() {
+		t.Fatalf("pause applied without persist")
+	}
+}
@@ -0,0 +1,131 @@
+package event
+
+// event types
+const TYPE_AUCTION = "auction"
+const TYPE_NOTICE = "notice"
+const TYPE_TRACKING = "tracking"
+
+// dsp states besides bid states
+const STATUS_SKIPPED = "skipped"
+
+// Event single entry written to sink
+type Event interface {
+	GetType() string
+	GetID() string
+	MarshalJSON() ([]byte, error)
+}
+
+// Sink destination of events
+// write must never block auction, event may be dropped
+type Sink interface {
+	Write(e Event) bool
+	Close() error
+}
+
+// Record what happened in single auction, prices in exchange currency unless noted
+type Record struct {
+	Type string `json:"type"`
+	ID string `json:"id"`
+	Time int64 `json:"time"`
+	Duration float64 `json:"duration_ms"`
+	Result string `json:"result"`
+	Error string `json:"error,omitempty"`
+	Cur string `json:"cur,omitempty"`
+	Dsp []DspRecord `json:"dsp"`
+	Imp []ImpRecord `json:"imp"`
+}
+
+// DspRecord invited or skipped dsp
+type DspRecord struct {
+	Name string `json:"name"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+	Latency float64 `json:"latency_ms,omitempty"`
+	Error []string `json:"error,omitempty"`
+	Bids []BidRecord `json:"bids,omitempty"`
+}
+
+// BidRecord single offer, price in offer currency
+type BidRecord struct {
+	ImpID string `json:"impid"`
+	ID string `json:"id,omitempty"`
+	Seat string `json:"seat,omitempty"`
+	Price float64 `json:"price"`
+	Cur string `json:"cur,omitempty"`
+	Value float64 `json:"value"`
+	Deal string `json:"deal,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ImpRecord impression outcome, no winner on no fill
+type ImpRecord struct {
+	ImpID string `json:"impid"`
+	Floor float64 `json:"floor,omitempty"`
+	Winner string `json:"winner,omitempty"`
+	BidID string `json:"bid_id,omitempty"`
+	Price float64 `json:"price,omitempty"`
+	Deal string `json:"deal,omitempty"`
+}
+
+// Notice win/loss notice fired to dsp
+type Notice struct {
+	Type string `json:"type"`
+	Time int64 `json:"time"`
+	Kind string `json:"kind"`
+	AuctionID string `json:"auction_id"`
+	ImpID string `json:"impid,omitempty"`
+	Dsp string `json:"dsp,omitempty"`
+	Loss int `json:"loss,omitempty"`
+	URL string `json:"url"`
+	Status string `json:"status"`
+	Attempts int `json:"attempts,omitempty"`
+	Duration float64 `json:"duration_ms,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Tracking impression, click or event fired by served creative
+type Tracking struct {
+	Type string `json:"type"`
+	Time int64 `json:"time"`
+	Kind string `json:"kind"`
+	Event string `json:"event,omitempty"`
+	AuctionID string `json:"auction_id"`
+	ImpID string `json:"impid"`
+	BidID string `json:"bid_id,omitempty"`
+	Dsp string `json:"dsp,omitempty"`
+	Price float64 `json:"price,omitempty"`
+	Cur string `json:"cur,omitempty"`
+	Status string `json:"status"`
+	Billable bool `json:"billable,omitempty"`
+	IP string `json:"ip,omitempty"`
+}
+
+// event type
+func (r *Record) GetType() string {
+	return r.Type
+}
+
+// auction id
+func (r *Record) GetID() string {
+	return r.ID
+}
+
+// event type
+func (n *Notice) GetType() string {
+	return n.Type
+}
+
+// auction id of notice
+func (n *Notice) GetID() string {
+	return n.AuctionID
+}
+
+// event type
+func (t *Tracking) GetType() string {
+	return t.Type
+}
+
+// auction id of fire
+func (t *Tracking) GetID() string {
+	return t.AuctionID
+}
@@ -0,0 +1,875 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package event
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF642ad3eDecodeAirpushAuctionEvent(in *jlexer.Lexer, out *Tracking) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "time":
+			out.Time = int64(in.Int64())
+		case "kind":
+			out.Kind = string(in.String())
+		case "event":
+			out.Event = string(in.String())
+		case "auction_id":
+			out.AuctionID = string(in.String())
+		case "impid":
+			out.ImpID = string(in.String())
+		case "bid_id":
+			out.BidID = string(in.String())
+		case "dsp":
+			out.Dsp = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "cur":
+			out.Cur = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "billable":
+			out.Billable = bool(in.Bool())
+		case "ip":
+			out.IP = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeAirpushAuctionEvent(out *jwriter.Writer, in Tracking) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	if in.Event != "" {
+		const prefix string = ",\"event\":"
+		out.RawString(prefix)
+		out.String(string(in.Event))
+	}
+	{
+		const prefix string = ",\"auction_id\":"
+		out.RawString(prefix)
+		out.String(string(in.AuctionID))
+	}
+	{
+		const prefix string = ",\"impid\":"
+		out.RawString(prefix)
+		out.String(string(in.ImpID))
+	}
+	if in.BidID != "" {
+		const prefix string = ",\"bid_id\":"
+		out.RawString(prefix)
+		out.String(string(in.BidID))
+	}
+	if in.Dsp != "" {
+		const prefix string = ",\"dsp\":"
+		out.RawString(prefix)
+		out.String(string(in.Dsp))
+	}
+	if in.Price != 0 {
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	if in.Cur != "" {
+		const prefix string = ",\"cur\":"
+		out.RawString(prefix)
+		out.String(string(in.Cur))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Billable {
+		const prefix string = ",\"billable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Billable))
+	}
+	if in.IP != "" {
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Tracking) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeAirpushAuctionEvent(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Tracking) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeAirpushAuctionEvent(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Tracking) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeAirpushAuctionEvent(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Tracking) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeAirpushAuctionEvent(l, v)
+}
+func easyjsonF642ad3eDecodeAirpushAuctionEvent1(in *jlexer.Lexer, out *Record) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "time":
+			out.Time = int64(in.Int64())
+		case "duration_ms":
+			out.Duration = float64(in.Float64())
+		case "result":
+			out.Result = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "cur":
+			out.Cur = string(in.String())
+		case "dsp":
+			if in.IsNull() {
+				in.Skip()
+				out.Dsp = nil
+			} else {
+				in.Delim('[')
+				if out.Dsp == nil {
+					if !in.IsDelim(']') {
+						out.Dsp = make([]DspRecord, 0, 0)
+					} else {
+						out.Dsp = []DspRecord{}
+					}
+				} else {
+					out.Dsp = (out.Dsp)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 DspRecord
+					(v1).UnmarshalEasyJSON(in)
+					out.Dsp = append(out.Dsp, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "imp":
+			if in.IsNull() {
+				in.Skip()
+				out.Imp = nil
+			} else {
+				in.Delim('[')
+				if out.Imp == nil {
+					if !in.IsDelim(']') {
+						out.Imp = make([]ImpRecord, 0, 0)
+					} else {
+						out.Imp = []ImpRecord{}
+					}
+				} else {
+					out.Imp = (out.Imp)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 ImpRecord
+					(v2).UnmarshalEasyJSON(in)
+					out.Imp = append(out.Imp, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeAirpushAuctionEvent1(out *jwriter.Writer, in Record) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"duration_ms\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Duration))
+	}
+	{
+		const prefix string = ",\"result\":"
+		out.RawString(prefix)
+		out.String(string(in.Result))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	if in.Cur != "" {
+		const prefix string = ",\"cur\":"
+		out.RawString(prefix)
+		out.String(string(in.Cur))
+	}
+	{
+		const prefix string = ",\"dsp\":"
+		out.RawString(prefix)
+		if in.Dsp == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.Dsp {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"imp\":"
+		out.RawString(prefix)
+		if in.Imp == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Imp {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Record) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeAirpushAuctionEvent1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Record) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeAirpushAuctionEvent1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Record) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeAirpushAuctionEvent1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Record) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeAirpushAuctionEvent1(l, v)
+}
+func easyjsonF642ad3eDecodeAirpushAuctionEvent2(in *jlexer.Lexer, out *Notice) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "time":
+			out.Time = int64(in.Int64())
+		case "kind":
+			out.Kind = string(in.String())
+		case "auction_id":
+			out.AuctionID = string(in.String())
+		case "impid":
+			out.ImpID = string(in.String())
+		case "dsp":
+			out.Dsp = string(in.String())
+		case "loss":
+			out.Loss = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "attempts":
+			out.Attempts = int(in.Int())
+		case "duration_ms":
+			out.Duration = float64(in.Float64())
+		case "error":
+			out.Error = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeAirpushAuctionEvent2(out *jwriter.Writer, in Notice) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix)
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"auction_id\":"
+		out.RawString(prefix)
+		out.String(string(in.AuctionID))
+	}
+	if in.ImpID != "" {
+		const prefix string = ",\"impid\":"
+		out.RawString(prefix)
+		out.String(string(in.ImpID))
+	}
+	if in.Dsp != "" {
+		const prefix string = ",\"dsp\":"
+		out.RawString(prefix)
+		out.String(string(in.Dsp))
+	}
+	if in.Loss != 0 {
+		const prefix string = ",\"loss\":"
+		out.RawString(prefix)
+		out.Int(int(in.Loss))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Attempts != 0 {
+		const prefix string = ",\"attempts\":"
+		out.RawString(prefix)
+		out.Int(int(in.Attempts))
+	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration_ms\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Duration))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notice) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeAirpushAuctionEvent2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notice) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeAirpushAuctionEvent2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notice) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeAirpushAuctionEvent2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notice) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeAirpushAuctionEvent2(l, v)
+}
+func easyjsonF642ad3eDecodeAirpushAuctionEvent3(in *jlexer.Lexer, out *ImpRecord) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "impid":
+			out.ImpID = string(in.String())
+		case "floor":
+			out.Floor = float64(in.Float64())
+		case "winner":
+			out.Winner = string(in.String())
+		case "bid_id":
+			out.BidID = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "deal":
+			out.Deal = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeAirpushAuctionEvent3(out *jwriter.Writer, in ImpRecord) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"impid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ImpID))
+	}
+	if in.Floor != 0 {
+		const prefix string = ",\"floor\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Floor))
+	}
+	if in.Winner != "" {
+		const prefix string = ",\"winner\":"
+		out.RawString(prefix)
+		out.String(string(in.Winner))
+	}
+	if in.BidID != "" {
+		const prefix string = ",\"bid_id\":"
+		out.RawString(prefix)
+		out.String(string(in.BidID))
+	}
+	if in.Price != 0 {
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	if in.Deal != "" {
+		const prefix string = ",\"deal\":"
+		out.RawString(prefix)
+		out.String(string(in.Deal))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImpRecord) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeAirpushAuctionEvent3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImpRecord) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeAirpushAuctionEvent3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImpRecord) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeAirpushAuctionEvent3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImpRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeAirpushAuctionEvent3(l, v)
+}
+func easyjsonF642ad3eDecodeAirpushAuctionEvent4(in *jlexer.Lexer, out *DspRecord) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "latency_ms":
+			out.Latency = float64(in.Float64())
+		case "error":
+			if in.IsNull() {
+				in.Skip()
+				out.Error = nil
+			} else {
+				in.Delim('[')
+				if out.Error == nil {
+					if !in.IsDelim(']') {
+						out.Error = make([]string, 0, 4)
+					} else {
+						out.Error = []string{}
+					}
+				} else {
+					out.Error = (out.Error)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Error = append(out.Error, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "bids":
+			if in.IsNull() {
+				in.Skip()
+				out.Bids = nil
+			} else {
+				in.Delim('[')
+				if out.Bids == nil {
+					if !in.IsDelim(']') {
+						out.Bids = make([]BidRecord, 0, 0)
+					} else {
+						out.Bids = []BidRecord{}
+					}
+				} else {
+					out.Bids = (out.Bids)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 BidRecord
+					(v8).UnmarshalEasyJSON(in)
+					out.Bids = append(out.Bids, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeAirpushAuctionEvent4(out *jwriter.Writer, in DspRecord) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.Latency != 0 {
+		const prefix string = ",\"latency_ms\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Latency))
+	}
+	if len(in.Error) != 0 {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v9, v10 := range in.Error {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Bids) != 0 {
+		const prefix string = ",\"bids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Bids {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DspRecord) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeAirpushAuctionEvent4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DspRecord) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeAirpushAuctionEvent4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DspRecord) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeAirpushAuctionEvent4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DspRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeAirpushAuctionEvent4(l, v)
+}
+func easyjsonF642ad3eDecodeAirpushAuctionEvent5(in *jlexer.Lexer, out *BidRecord) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "impid":
+			out.ImpID = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "seat":
+			out.Seat = string(in.String())
+		case "price":
+			out.Price = float64(in.Float64())
+		case "cur":
+			out.Cur = string(in.String())
+		case "value":
+			out.Value = float64(in.Float64())
+		case "deal":
+			out.Deal = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF642ad3eEncodeAirpushAuctionEvent5(out *jwriter.Writer, in BidRecord) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"impid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ImpID))
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	if in.Seat != "" {
+		const prefix string = ",\"seat\":"
+		out.RawString(prefix)
+		out.String(string(in.Seat))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	if in.Cur != "" {
+		const prefix string = ",\"cur\":"
+		out.RawString(prefix)
+		out.String(string(in.Cur))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Value))
+	}
+	if in.Deal != "" {
+		const prefix string = ",\"deal\":"
+		out.RawString(prefix)
+		out.String(string(in.Deal))
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BidRecord) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF642ad3eEncodeAirpushAuctionEvent5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BidRecord) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF642ad3eEncodeAirpushAuctionEvent5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BidRecord) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF642ad3eDecodeAirpushAuctionEvent5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BidRecord) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF642ad3eDecodeAirpushAuctionEvent5(l, v)
+}
@@ -0,0 +1,294 @@
+package event
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaults
+const DEFAULT_PREFIX = "auction"
+const DEFAULT_QUEUE = 4096
+const DEFAULT_MAX_SIZE = int64(100) << 20
+const DEFAULT_INTERVAL = time.Hour
+const DEFAULT_FLUSH = time.Second
+
+// file name parts
+const FILE_TIME_FORMAT = "20060102-150405"
+const FILE_EXT = ".jsonl"
+
+// write buffer of open file
+const FILE_BUFFER_SIZE = 64 << 10
+
+// logger interface
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// settings setter
+type FileOption func(*File)
+
+// directory of log files
+func SetDir(dir string) FileOption {
+	return func(t *File) {
+		t.dir = dir
+	}
+}
+
+// file name prefix
+func SetPrefix(prefix string) FileOption {
+	return func(t *File) {
+		if prefix != "" {
+			t.prefix = prefix
+		}
+	}
+}
+
+// max events waiting to be written, overflow is dropped
+func SetQueueSize(n int) FileOption {
+	return func(t *File) {
+		if n > 0 {
+			t.size = n
+		}
+	}
+}
+
+// file is rotated when it grow over size in bytes
+func SetMaxSize(size int64) FileOption {
+	return func(t *File) {
+		if size > 0 {
+			t.maxSize = size
+		}
+	}
+}
+
+// file is rotated when it is open longer than interval
+func SetInterval(duration time.Duration) FileOption {
+	return func(t *File) {
+		if duration > 0 {
+			t.interval = duration
+		}
+	}
+}
+
+// period buffered events are flushed to file
+func SetFlush(duration time.Duration) FileOption {
+	return func(t *File) {
+		if duration > 0 {
+			t.flush = duration
+		}
+	}
+}
+
+// logger
+func SetLogger(logger Logger) FileOption {
+	return func(t *File) {
+		t.logger = logger
+	}
+}
+
+// File sink writing events as json lines, rotated by size and time
+// events are written by single goroutine, caller never wait on disk
+type File struct {
+	dir string
+	prefix string
+	size int
+	maxSize int64
+	interval time.Duration
+	flush time.Duration
+	queue chan Event
+	mu sync.RWMutex
+	closed bool
+	wg sync.WaitGroup
+	dropped uint64
+	logger Logger
+
+	// owned by writer goroutine
+	file *os.File
+	buf *bufio.Writer
+	written int64
+	opened time.Time
+}
+
+// init file sink, directory is created
+func NewFile(opts ...FileOption) (proto *File, err error) {
+
+	proto = &File{
+		dir: ".",
+		prefix: DEFAULT_PREFIX,
+		size: DEFAULT_QUEUE,
+		maxSize: DEFAULT_MAX_SIZE,
+		interval: DEFAULT_INTERVAL,
+		flush: DEFAULT_FLUSH,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	err = os.MkdirAll(proto.dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	proto.queue = make(chan Event, proto.size)
+
+	return
+}
+
+// run writer
+func (f *File) Start() {
+	f.wg.Add(1)
+	go f.run()
+}
+
+// stop accept events, write queued ones and close file
+func (f *File) Close() error {
+
+	f.mu.Lock()
+	if !f.closed {
+		f.closed = true
+		close(f.queue)
+	}
+	f.mu.Unlock()
+
+	f.wg.Wait()
+	return nil
+}
+
+// queue event, never block caller, event after close is dropped
+func (f *File) Write(e Event) bool {
+	defer f.mu.RUnlock()
+	f.mu.RLock()
+
+	if f.closed {
+		atomic.AddUint64(&f.dropped, 1)
+		return false
+	}
+
+	select {
+	case f.queue <- e:
+		return true
+	default:
+		atomic.AddUint64(&f.dropped, 1)
+		return false
+	}
+}
+
+// count of dropped events
+func (f *File) GetDropped() uint64 {
+	return atomic.LoadUint64(&f.dropped)
+}
+
+// writer loop
+func (f *File) run() {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(f.flush)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case e, ok := <-f.queue:
+			if !ok {
+				f.closeFile()
+				return
+			}
+			if err := f.write(e); err != nil {
+				f.logf("write %s event %s fail: %s", e.GetType(), e.GetID(), err)
+			}
+		case <-ticker.C:
+			// rotate idle file, new one is open on next event
+			if f.file != nil && time.Since(f.opened) >= f.interval {
+				f.closeFile()
+			} else if f.buf != nil {
+				if err := f.buf.Flush(); err != nil {
+					f.logf("flush event log fail: %s", err)
+				}
+			}
+		}
+	}
+}
+
+// append event line, rotate file before when needed
+func (f *File) write(e Event) error {
+
+	buf, err := e.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	buf = append(buf, '\n')
+
+	if f.file != nil && (f.written+int64(len(buf)) > f.maxSize || time.Since(f.opened) >= f.interval) {
+		f.closeFile()
+	}
+
+	if f.file == nil {
+		err = f.openFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := f.buf.Write(buf)
+	f.written += int64(n)
+
+	return err
+}
+
+// open new file named by open time
+func (f *File) openFile() (err error) {
+
+	now := time.Now()
+	stamp := now.UTC().Format(FILE_TIME_FORMAT)
+	name := filepath.Join(f.dir, f.prefix+"-"+stamp+FILE_EXT)
+
+	// several rotations in same second
+	for i := 1; ; i++ {
+		f.file, err = os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if !os.IsExist(err) {
+			break
+		}
+		name = filepath.Join(f.dir, fmt.Sprintf("%s-%s.%d%s", f.prefix, stamp, i, FILE_EXT))
+	}
+	if err != nil {
+		f.file = nil
+		return
+	}
+
+	f.buf = bufio.NewWriterSize(f.file, FILE_BUFFER_SIZE)
+	f.written = 0
+	f.opened = now
+
+	return
+}
+
+// flush and close current file
+func (f *File) closeFile() {
+
+	if f.file == nil {
+		return
+	}
+
+	if err := f.buf.Flush(); err != nil {
+		f.logf("flush event log fail: %s", err)
+	}
+	if err := f.file.Close(); err != nil {
+		f.logf("close event log fail: %s", err)
+	}
+
+	f.file = nil
+	f.buf = nil
+}
+
+// log error when logger is set
+func (f *File) logf(format string, args ...interface{}) {
+	if f.logger != nil {
+		f.logger.Printf(format, args...)
+	}
+}
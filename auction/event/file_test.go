@@ -0,0 +1,151 @@
+package event
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// file sink in temp dir
+func testFile(t *testing.T, opts ...FileOption) (*File, string) {
+
+	dir, err := ioutil.TempDir("", "events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	f, err := NewFile(append([]FileOption{SetDir(dir), SetPrefix("test")}, opts...)...)
+	if err != nil {
+		t.Fatalf("file: %s", err)
+	}
+
+	return f, dir
+}
+
+// lines of every event file in dir
+func lines(t *testing.T, dir string) (files int, res []string) {
+
+	names, err := filepath.Glob(filepath.Join(dir, "test-*" + FILE_EXT))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range names {
+		file, err := os.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			res = append(res, scanner.Text())
+		}
+		file.Close()
+	}
+
+	return len(names), res
+}
+
+func TestFileCloseFlush(t *testing.T) {
+
+	// flush period never pass, events are written on close
+	f, dir := testFile(t, SetFlush(time.Hour))
+	f.Start()
+
+	for _, id := range []string{"a", "b", "c"} {
+		if !f.Write(&Record{Type: TYPE_AUCTION, ID: id}) {
+			t.Fatalf("event %s not queued", id)
+		}
+	}
+
+	if err := f.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+
+	files, got := lines(t, dir)
+	if files != 1 || len(got) != 3 || !strings.Contains(got[2], `"id":"c"`) {
+		t.Fatalf("files %d lines %v", files, got)
+	}
+}
+
+func TestFileRotateBySize(t *testing.T) {
+
+	line, _ := (&Record{Type: TYPE_AUCTION, ID: "a"}).MarshalJSON()
+
+	// two lines fit in file
+	f, dir := testFile(t, SetMaxSize(int64(2 * (len(line) + 1))))
+	f.Start()
+
+	for i := 0; i < 5; i++ {
+		f.Write(&Record{Type: TYPE_AUCTION, ID: "a"})
+	}
+	f.Close()
+
+	files, got := lines(t, dir)
+	if files != 3 || len(got) != 5 {
+		t.Fatalf("files %d lines %d, want 3 files of 5 lines", files, len(got))
+	}
+}
+
+func TestFileRotateByInterval(t *testing.T) {
+
+	// every event open new file, names in same second get suffix
+	f, dir := testFile(t, SetInterval(time.Nanosecond))
+	f.Start()
+
+	for i := 0; i < 3; i++ {
+		f.Write(&Record{Type: TYPE_AUCTION, ID: "a"})
+	}
+	f.Close()
+
+	files, got := lines(t, dir)
+	if files != 3 || len(got) != 3 {
+		t.Fatalf("files %d lines %d, want 3", files, len(got))
+	}
+}
+
+func TestFileDropOnFull(t *testing.T) {
+
+	// writer is not started, queue is not read
+	f, dir := testFile(t, SetQueueSize(2))
+
+	results := []bool{}
+	for i := 0; i < 3; i++ {
+		results = append(results, f.Write(&Record{Type: TYPE_AUCTION, ID: "a"}))
+	}
+
+	if !results[0] || !results[1] || results[2] || f.GetDropped() != 1 {
+		t.Fatalf("queued %v dropped %d", results, f.GetDropped())
+	}
+
+	// queued events are written on close
+	f.Start()
+	f.Close()
+
+	if _, got := lines(t, dir); len(got) != 2 {
+		t.Fatalf("lines %d, want 2", len(got))
+	}
+}
+
+func TestFileWriteAfterClose(t *testing.T) {
+
+	f, _ := testFile(t)
+	f.Start()
+
+	if err := f.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+
+	if f.Write(&Record{Type: TYPE_AUCTION, ID: "a"}) || f.GetDropped() != 1 {
+		t.Fatalf("event accepted after close, dropped %d", f.GetDropped())
+	}
+
+	// second close is noop
+	if err := f.Close(); err != nil {
+		t.Fatalf("second close: %s", err)
+	}
+}
@@ -0,0 +1,336 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/compress"
+)
+
+// kafka defaults
+const DEFAULT_KAFKA_TOPIC = "auction-events"
+const DEFAULT_KAFKA_QUEUE = 4096
+const DEFAULT_KAFKA_IN_FLIGHT = 16384
+const DEFAULT_KAFKA_BATCH_SIZE = 500
+const DEFAULT_KAFKA_BATCH_TIMEOUT = time.Duration(200) * time.Millisecond
+const DEFAULT_KAFKA_RETRIES = 3
+const DEFAULT_KAFKA_TIMEOUT = time.Duration(5) * time.Second
+
+// header of message with event type
+const KAFKA_HEADER_TYPE = "type"
+
+// supported compression codecs
+const COMPRESSION_NONE = "none"
+const COMPRESSION_GZIP = "gzip"
+const COMPRESSION_SNAPPY = "snappy"
+const COMPRESSION_LZ4 = "lz4"
+const COMPRESSION_ZSTD = "zstd"
+
+// settings setter
+type KafkaOption func(*Kafka)
+
+// bootstrap brokers host:port
+func SetKafkaBrokers(brokers []string) KafkaOption {
+	return func(t *Kafka) {
+		t.brokers = brokers
+	}
+}
+
+// topic events are produced to
+func SetKafkaTopic(topic string) KafkaOption {
+	return func(t *Kafka) {
+		if topic != "" {
+			t.topic = topic
+		}
+	}
+}
+
+// max events waiting to be produced, overflow is dropped
+func SetKafkaQueueSize(n int) KafkaOption {
+	return func(t *Kafka) {
+		if n > 0 {
+			t.size = n
+		}
+	}
+}
+
+// max events handed to writer and not delivered yet, overflow is dropped
+func SetKafkaInFlight(n int) KafkaOption {
+	return func(t *Kafka) {
+		if n > 0 {
+			t.maxInFlight = int64(n)
+		}
+	}
+}
+
+// max events in single produce request
+func SetKafkaBatchSize(n int) KafkaOption {
+	return func(t *Kafka) {
+		if n > 0 {
+			t.batchSize = n
+		}
+	}
+}
+
+// max time incomplete batch wait before produced
+func SetKafkaBatchTimeout(duration time.Duration) KafkaOption {
+	return func(t *Kafka) {
+		if duration > 0 {
+			t.batchTimeout = duration
+		}
+	}
+}
+
+// compression codec none/gzip/snappy/lz4/zstd
+func SetKafkaCompression(codec string) KafkaOption {
+	return func(t *Kafka) {
+		if codec != "" {
+			t.compression = codec
+		}
+	}
+}
+
+// retries of failed produce request
+func SetKafkaRetries(n int) KafkaOption {
+	return func(t *Kafka) {
+		if n >= 0 {
+			t.retries = n
+		}
+	}
+}
+
+// logger
+func SetKafkaLogger(logger Logger) KafkaOption {
+	return func(t *Kafka) {
+		t.logger = logger
+	}
+}
+
+// Kafka sink producing events as json messages keyed by auction id
+// caller only queue event, single goroutine hand events to async writer
+// which batch, compress and retry them, overflow of queue is dropped
+// events in writer are counted until delivered, overflow of in flight cap is dropped
+type Kafka struct {
+	brokers []string
+	topic string
+	size int
+	maxInFlight int64
+	inFlight int64
+	batchSize int
+	batchTimeout time.Duration
+	compression string
+	retries int
+	writer *kafka.Writer
+	queue chan Event
+	mu sync.RWMutex
+	closed bool
+	wg sync.WaitGroup
+	dropped uint64
+	failed uint64
+	logger Logger
+}
+
+// init kafka sink, no connection is made before first event
+func NewKafka(opts ...KafkaOption) (proto *Kafka, err error) {
+
+	proto = &Kafka{
+		topic: DEFAULT_KAFKA_TOPIC,
+		size: DEFAULT_KAFKA_QUEUE,
+		maxInFlight: DEFAULT_KAFKA_IN_FLIGHT,
+		batchSize: DEFAULT_KAFKA_BATCH_SIZE,
+		batchTimeout: DEFAULT_KAFKA_BATCH_TIMEOUT,
+		compression: COMPRESSION_SNAPPY,
+		retries: DEFAULT_KAFKA_RETRIES,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	if len(proto.brokers) == 0 {
+		return nil, fmt.Errorf("kafka brokers are empty")
+	}
+
+	codec, err := Compression(proto.compression)
+	if err != nil {
+		return nil, err
+	}
+
+	proto.writer = &kafka.Writer{
+		Addr: kafka.TCP(proto.brokers...),
+		Topic: proto.topic,
+		Balancer: &kafka.Hash{},
+		BatchSize: proto.batchSize,
+		BatchTimeout: proto.batchTimeout,
+		MaxAttempts: proto.retries + 1,
+		WriteTimeout: DEFAULT_KAFKA_TIMEOUT,
+		ReadTimeout: DEFAULT_KAFKA_TIMEOUT,
+		RequiredAcks: kafka.RequireOne,
+		Compression: codec,
+		Async: true,
+		Completion: proto.complete,
+	}
+
+	proto.queue = make(chan Event, proto.size)
+
+	return
+}
+
+// kafka codec by name
+func Compression(codec string) (kafka.Compression, error) {
+	switch strings.ToLower(codec) {
+	case "", COMPRESSION_NONE:
+		return 0, nil
+	case COMPRESSION_GZIP:
+		return compress.Gzip, nil
+	case COMPRESSION_SNAPPY:
+		return compress.Snappy, nil
+	case COMPRESSION_LZ4:
+		return compress.Lz4, nil
+	case COMPRESSION_ZSTD:
+		return compress.Zstd, nil
+	}
+	return 0, fmt.Errorf("unknown kafka compression %q", codec)
+}
+
+// run producer
+func (k *Kafka) Start() {
+	k.wg.Add(1)
+	go k.run()
+}
+
+// stop accept events, produce queued and pending ones
+func (k *Kafka) Close() error {
+
+	k.mu.Lock()
+	if k.closed {
+		k.mu.Unlock()
+		return nil
+	}
+	k.closed = true
+	close(k.queue)
+	k.mu.Unlock()
+
+	k.wg.Wait()
+	return k.writer.Close()
+}
+
+// queue event, never block caller, event after close is dropped
+func (k *Kafka) Write(e Event) bool {
+	defer k.mu.RUnlock()
+	k.mu.RLock()
+
+	if k.closed {
+		atomic.AddUint64(&k.dropped, 1)
+		return false
+	}
+
+	select {
+	case k.queue <- e:
+		return true
+	default:
+		atomic.AddUint64(&k.dropped, 1)
+		return false
+	}
+}
+
+// count of dropped events
+func (k *Kafka) GetDropped() uint64 {
+	return atomic.LoadUint64(&k.dropped)
+}
+
+// count of events handed to writer and not delivered yet
+func (k *Kafka) GetInFlight() int64 {
+	return atomic.LoadInt64(&k.inFlight)
+}
+
+// count of events not delivered after all retries
+func (k *Kafka) GetFailed() uint64 {
+	return atomic.LoadUint64(&k.failed)
+}
+
+// producer loop, take every queued event at once up to batch size
+func (k *Kafka) run() {
+	defer k.wg.Done()
+
+	msgs := make([]kafka.Message, 0, k.batchSize)
+	for e := range k.queue {
+
+		msgs = k.append(msgs[:0], e)
+
+	more:
+		for len(msgs) < k.batchSize {
+			select {
+			case e, ok := <-k.queue:
+				if !ok {
+					break more
+				}
+				msgs = k.append(msgs, e)
+			default:
+				break more
+			}
+		}
+
+		if len(msgs) == 0 {
+			continue
+		}
+
+		// writer queue is unbounded, batch over in flight cap is dropped
+		n := int64(len(msgs))
+		if atomic.AddInt64(&k.inFlight, n) > k.maxInFlight {
+			atomic.AddInt64(&k.inFlight, -n)
+			atomic.AddUint64(&k.dropped, uint64(n))
+			continue
+		}
+
+		// async writer return at once, metadata lookup is bounded by timeout
+		// messages are passed to completion only when write succeed
+		ctx, cancel := context.WithTimeout(context.Background(), DEFAULT_KAFKA_TIMEOUT)
+		err := k.writer.WriteMessages(ctx, msgs...)
+		cancel()
+
+		if err != nil {
+			atomic.AddInt64(&k.inFlight, -n)
+			atomic.AddUint64(&k.failed, uint64(n))
+			k.logf("produce %d events fail: %s", n, err)
+		}
+	}
+}
+
+// append event as message, broken event is skipped
+func (k *Kafka) append(msgs []kafka.Message, e Event) []kafka.Message {
+
+	buf, err := e.MarshalJSON()
+	if err != nil {
+		k.logf("marshal %s event %s fail: %s", e.GetType(), e.GetID(), err)
+		return msgs
+	}
+
+	return append(msgs, kafka.Message{
+		Key: []byte(e.GetID()),
+		Value: buf,
+		Headers: []kafka.Header{{Key: KAFKA_HEADER_TYPE, Value: []byte(e.GetType())}},
+	})
+}
+
+// delivery result of batch
+func (k *Kafka) complete(msgs []kafka.Message, err error) {
+	atomic.AddInt64(&k.inFlight, -int64(len(msgs)))
+	if err != nil {
+		atomic.AddUint64(&k.failed, uint64(len(msgs)))
+		k.logf("deliver %d events fail: %s", len(msgs), err)
+	}
+}
+
+// log error when logger is set
+func (k *Kafka) logf(format string, args ...interface{}) {
+	if k.logger != nil {
+		k.logger.Printf(format, args...)
+	}
+}
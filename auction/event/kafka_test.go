@@ -0,0 +1,70 @@
+package event
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func TestKafkaInFlightCap(t *testing.T) {
+
+	// no broker is contacted, batch over cap is dropped before write
+	k, err := NewKafka(SetKafkaBrokers([]string{"127.0.0.1:1"}), SetKafkaInFlight(10))
+	if err != nil {
+		t.Fatalf("kafka: %s", err)
+	}
+	atomic.StoreInt64(&k.inFlight, 10)
+
+	k.Start()
+	for _, id := range []string{"a", "b"} {
+		if !k.Write(&Record{Type: TYPE_AUCTION, ID: id}) {
+			t.Fatalf("event %s not queued", id)
+		}
+	}
+	if err := k.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+
+	if k.GetDropped() != 2 || k.GetFailed() != 0 || k.GetInFlight() != 10 {
+		t.Fatalf("dropped %d failed %d in flight %d", k.GetDropped(), k.GetFailed(), k.GetInFlight())
+	}
+}
+
+func TestKafkaCompletionReleaseInFlight(t *testing.T) {
+
+	k, err := NewKafka(SetKafkaBrokers([]string{"127.0.0.1:1"}))
+	if err != nil {
+		t.Fatalf("kafka: %s", err)
+	}
+	atomic.StoreInt64(&k.inFlight, 5)
+
+	k.complete(make([]kafka.Message, 3), nil)
+	k.complete(make([]kafka.Message, 2), fmt.Errorf("broker down"))
+
+	if k.GetInFlight() != 0 || k.GetFailed() != 2 {
+		t.Fatalf("in flight %d failed %d", k.GetInFlight(), k.GetFailed())
+	}
+}
+
+func TestKafkaWriteAfterClose(t *testing.T) {
+
+	k, err := NewKafka(SetKafkaBrokers([]string{"127.0.0.1:1"}))
+	if err != nil {
+		t.Fatalf("kafka: %s", err)
+	}
+
+	k.Start()
+	if err := k.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+
+	if k.Write(&Record{Type: TYPE_AUCTION, ID: "a"}) || k.GetDropped() != 1 {
+		t.Fatalf("event accepted after close, dropped %d", k.GetDropped())
+	}
+
+	if err := k.Close(); err != nil {
+		t.Fatalf("second close: %s", err)
+	}
+}
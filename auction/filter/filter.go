@@ -0,0 +1,171 @@
+package filter
+
+import (
+	"airpush/auction/openrtb"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// inventory types
+const INVENTORY_SITE = "site"
+const INVENTORY_APP = "app"
+
+// Filter targeting of dsp, empty field match any request
+type Filter struct {
+	Countries      []string `json:"countries,omitempty" yaml:"countries,omitempty" mapstructure:"countries"`
+	DeviceTypes    []int    `json:"device_types,omitempty" yaml:"device_types,omitempty" mapstructure:"device_types"`
+	OS             []string `json:"os,omitempty" yaml:"os,omitempty" mapstructure:"os"`
+	Formats        []string `json:"formats,omitempty" yaml:"formats,omitempty" mapstructure:"formats"`
+	Sizes          []string `json:"sizes,omitempty" yaml:"sizes,omitempty" mapstructure:"sizes"`
+	Inventory      string   `json:"inventory,omitempty" yaml:"inventory,omitempty" mapstructure:"inventory"`
+	PublisherAllow []string `json:"publisher_allow,omitempty" yaml:"publisher_allow,omitempty" mapstructure:"publisher_allow"`
+	PublisherDeny  []string `json:"publisher_deny,omitempty" yaml:"publisher_deny,omitempty" mapstructure:"publisher_deny"`
+	Hours          []int    `json:"hours,omitempty" yaml:"hours,omitempty" mapstructure:"hours"`
+}
+
+// all problems of targeting settings
+func (f *Filter) Check() (res []error) {
+
+	for _, format := range f.Formats {
+		switch format {
+		case openrtb.FORMAT_BANNER, openrtb.FORMAT_VIDEO, openrtb.FORMAT_AUDIO, openrtb.FORMAT_NATIVE:
+		default:
+			res = append(res, fmt.Errorf("unknown format %q", format))
+		}
+	}
+
+	for _, size := range f.Sizes {
+		var w, h int
+		if n, err := fmt.Sscanf(size, "%dx%d", &w, &h); err != nil || n != 2 || w <= 0 || h <= 0 || fmt.Sprintf("%dx%d", w, h) != size {
+			res = append(res, fmt.Errorf("size %q is not WxH", size))
+		}
+	}
+
+	if f.Inventory != "" && f.Inventory != INVENTORY_SITE && f.Inventory != INVENTORY_APP {
+		res = append(res, fmt.Errorf("unknown inventory %q", f.Inventory))
+	}
+
+	for _, h := range f.Hours {
+		if h < 0 || h > 23 {
+			res = append(res, fmt.Errorf("hour %d is out of 0..23", h))
+		}
+	}
+
+	return
+}
+
+// is request match dsp targeting, hours are in UTC
+func (f *Filter) Match(req *openrtb.BidRequest, now time.Time) bool {
+
+	if len(f.Countries) > 0 && !containsFold(f.Countries, req.GetCountry()) {
+		return false
+	}
+
+	if len(f.DeviceTypes) > 0 && (req.Device == nil || !containsInt(f.DeviceTypes, req.Device.DeviceType)) {
+		return false
+	}
+
+	if len(f.OS) > 0 && (req.Device == nil || !containsFold(f.OS, req.Device.OS)) {
+		return false
+	}
+
+	switch f.Inventory {
+	case INVENTORY_SITE:
+		if req.Site == nil {
+			return false
+		}
+	case INVENTORY_APP:
+		if req.App == nil {
+			return false
+		}
+	}
+
+	publisher := req.GetPublisherID()
+	if len(f.PublisherAllow) > 0 && !contains(f.PublisherAllow, publisher) {
+		return false
+	}
+
+	if len(f.PublisherDeny) > 0 && contains(f.PublisherDeny, publisher) {
+		return false
+	}
+
+	if len(f.Hours) > 0 && !containsInt(f.Hours, now.UTC().Hour()) {
+		return false
+	}
+
+	// at least one impression of wanted format and size
+	if len(f.Formats) > 0 || len(f.Sizes) > 0 {
+		for i := range req.Imp {
+			if f.matchImp(&req.Imp[i]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// is impression match formats and sizes
+func (f *Filter) matchImp(imp *openrtb.Imp) bool {
+
+	if len(f.Formats) > 0 {
+		ok := false
+		for _, format := range f.Formats {
+			if imp.HasFormat(format) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	if len(f.Sizes) > 0 {
+		for _, size := range sizes(imp) {
+			if contains(f.Sizes, size) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// sizes offered by impression as WxH
+func sizes(imp *openrtb.Imp) (res []string) {
+	for _, f := range imp.GetSizes() {
+		res = append(res, fmt.Sprintf("%dx%d", f.W, f.H))
+	}
+	return
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, v string) bool {
+	for _, s := range list {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(list []int, v int) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
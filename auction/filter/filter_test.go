@@ -0,0 +1,100 @@
+package filter
+
+import (
+	"airpush/auction/openrtb"
+	"testing"
+	"time"
+)
+
+// noon UTC
+var TEST_NOW = time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+
+// request from country, os and device type with single impression
+func request(country, os string, deviceType int, imp openrtb.Imp) *openrtb.BidRequest {
+	return &openrtb.BidRequest{
+		ID: "1",
+		Site: &openrtb.Site{Publisher: &openrtb.Publisher{ID: "p1"}},
+		Device: &openrtb.Device{Geo: &openrtb.Geo{Country: country}, OS: os, DeviceType: deviceType},
+		Imp: []openrtb.Imp{imp},
+	}
+}
+
+func TestMatch(t *testing.T) {
+
+	banner := openrtb.Imp{ID: "1", Banner: &openrtb.Banner{W: 300, H: 250, Format: []openrtb.Format{{W: 320, H: 50}}}}
+	video := openrtb.Imp{ID: "1", Video: &openrtb.Video{W: 640, H: 480}}
+
+	cases := []struct {
+		name string
+		filter Filter
+		req *openrtb.BidRequest
+		match bool
+	}{
+		{"empty filter", Filter{}, request("USA", "iOS", 4, banner), true},
+		{"country", Filter{Countries: []string{"CAN", "USA"}}, request("USA", "iOS", 4, banner), true},
+		{"other country", Filter{Countries: []string{"CAN"}}, request("USA", "iOS", 4, banner), false},
+		{"country case ignored", Filter{Countries: []string{"usa"}}, request("USA", "iOS", 4, banner), true},
+		{"country without geo", Filter{Countries: []string{"USA"}}, &openrtb.BidRequest{Imp: []openrtb.Imp{banner}}, false},
+		{"device type", Filter{DeviceTypes: []int{1, 4}}, request("USA", "iOS", 4, banner), true},
+		{"other device type", Filter{DeviceTypes: []int{2}}, request("USA", "iOS", 4, banner), false},
+		{"device type without device", Filter{DeviceTypes: []int{4}}, &openrtb.BidRequest{Imp: []openrtb.Imp{banner}}, false},
+		{"os case ignored", Filter{OS: []string{"ios"}}, request("USA", "iOS", 4, banner), true},
+		{"other os", Filter{OS: []string{"android"}}, request("USA", "iOS", 4, banner), false},
+		{"format", Filter{Formats: []string{openrtb.FORMAT_VIDEO}}, request("USA", "iOS", 4, video), true},
+		{"other format", Filter{Formats: []string{openrtb.FORMAT_VIDEO}}, request("USA", "iOS", 4, banner), false},
+		{"banner format size", Filter{Sizes: []string{"320x50"}}, request("USA", "iOS", 4, banner), true},
+		{"video size", Filter{Formats: []string{openrtb.FORMAT_VIDEO}, Sizes: []string{"640x480"}}, request("USA", "iOS", 4, video), true},
+		{"format of other size", Filter{Formats: []string{openrtb.FORMAT_BANNER}, Sizes: []string{"728x90"}}, request("USA", "iOS", 4, banner), false},
+		{"site inventory", Filter{Inventory: INVENTORY_SITE}, request("USA", "iOS", 4, banner), true},
+		{"app inventory", Filter{Inventory: INVENTORY_APP}, request("USA", "iOS", 4, banner), false},
+		{"publisher allowed", Filter{PublisherAllow: []string{"p1"}}, request("USA", "iOS", 4, banner), true},
+		{"publisher not allowed", Filter{PublisherAllow: []string{"p2"}}, request("USA", "iOS", 4, banner), false},
+		{"publisher denied", Filter{PublisherDeny: []string{"p1"}}, request("USA", "iOS", 4, banner), false},
+		{"hour", Filter{Hours: []int{11, 12}}, request("USA", "iOS", 4, banner), true},
+		{"other hour", Filter{Hours: []int{13}}, request("USA", "iOS", 4, banner), false},
+		{"all fields", Filter{Countries: []string{"usa"}, DeviceTypes: []int{4}, OS: []string{"IOS"}, Formats: []string{openrtb.FORMAT_BANNER}, Sizes: []string{"300x250"}},
+			request("USA", "iOS", 4, banner), true},
+		{"one field not matched", Filter{Countries: []string{"usa"}, DeviceTypes: []int{4}, OS: []string{"android"}},
+			request("USA", "iOS", 4, banner), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if match := c.filter.Match(c.req, TEST_NOW); match != c.match {
+				t.Fatalf("match %t, want %t", match, c.match)
+			}
+		})
+	}
+}
+
+func TestMatchAnyImp(t *testing.T) {
+
+	req := request("USA", "iOS", 4, openrtb.Imp{ID: "1", Banner: &openrtb.Banner{W: 300, H: 250}})
+	req.Imp = append(req.Imp, openrtb.Imp{ID: "2", Video: &openrtb.Video{W: 640, H: 480}})
+
+	f := &Filter{Formats: []string{openrtb.FORMAT_VIDEO}}
+	if !f.Match(req, TEST_NOW) {
+		t.Fatalf("request with video impression not matched")
+	}
+}
+
+func TestCheck(t *testing.T) {
+
+	cases := []struct {
+		name string
+		filter Filter
+		problems int
+	}{
+		{"valid", Filter{Formats: []string{openrtb.FORMAT_BANNER}, Sizes: []string{"300x250"}, Inventory: INVENTORY_APP, Hours: []int{0, 23}}, 0},
+		{"unknown format", Filter{Formats: []string{"popup"}}, 1},
+		{"bad sizes", Filter{Sizes: []string{"300", "0x50", "300x250x1", "+300x250"}}, 4},
+		{"unknown inventory", Filter{Inventory: "dooh"}, 1},
+		{"hours out of range", Filter{Hours: []int{-1, 24}}, 2},
+	}
+
+	for _, c := range cases {
+		if problems := c.filter.Check(); len(problems) != c.problems {
+			t.Fatalf("%s: problems %v, want %d", c.name, problems, c.problems)
+		}
+	}
+}
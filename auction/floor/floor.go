@@ -0,0 +1,175 @@
+package floor
+
+import (
+	"airpush/auction/openrtb"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Rule reserve price for matched inventory
+// empty field match any value
+type Rule struct {
+	Publisher  string  `json:"publisher,omitempty" yaml:"publisher"`
+	Placement  string  `json:"placement,omitempty" yaml:"placement"`
+	Format     string  `json:"format,omitempty" yaml:"format"`
+	Country    string  `json:"country,omitempty" yaml:"country"`
+	DeviceType int     `json:"device_type,omitempty" yaml:"device_type"`
+	Floor      float64 `json:"floor" yaml:"floor"`
+}
+
+// rules file model
+type config struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// settings setter
+type FloorsOption func(*Floors)
+
+// initial rules
+func SetRules(rules []Rule) FloorsOption {
+	return func(f *Floors) {
+		f.rules = rules
+	}
+}
+
+// Floors rules engine
+type Floors struct {
+	mu    sync.RWMutex
+	rules []Rule
+}
+
+// init floors
+func New(opts ...FloorsOption) (proto *Floors) {
+
+	proto = &Floors{}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// load rules from yaml file
+func (f *Floors) Load(path string) error {
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	c := config{}
+	err = yaml.Unmarshal(buf, &c)
+	if err != nil {
+		return err
+	}
+
+	err = Check(c.Rules)
+	if err != nil {
+		return err
+	}
+
+	f.Set(c.Rules)
+
+	return nil
+}
+
+// rules are valid, floor must be finite and not negative
+func Check(rules []Rule) error {
+
+	for i, r := range rules {
+		if math.IsNaN(r.Floor) || math.IsInf(r.Floor, 0) || r.Floor < 0 {
+			return fmt.Errorf("rule %d: floor must be finite and not negative, got %v", i, r.Floor)
+		}
+	}
+
+	return nil
+}
+
+// replace rules
+func (f *Floors) Set(rules []Rule) {
+	defer f.mu.Unlock()
+	f.mu.Lock()
+
+	f.rules = rules
+}
+
+// get rules
+func (f *Floors) Get() []Rule {
+	defer f.mu.RUnlock()
+	f.mu.RLock()
+
+	return f.rules
+}
+
+// raise imp.bidfloor of every impression up to matched rule
+func (f *Floors) Apply(req *openrtb.BidRequest) {
+	defer f.mu.RUnlock()
+	f.mu.RLock()
+
+	for i := range req.Imp {
+		if floor, ok := f.match(req, &req.Imp[i]); ok && floor > req.Imp[i].BidFloor {
+			req.Imp[i].BidFloor = floor
+		}
+	}
+}
+
+// most specific rule win, on equal specific higher floor win
+func (f *Floors) match(req *openrtb.BidRequest, imp *openrtb.Imp) (floor float64, ok bool) {
+
+	best := -1
+	for _, r := range f.rules {
+
+		score := 0
+
+		if r.Publisher != "" {
+			if r.Publisher != req.GetPublisherID() {
+				continue
+			}
+			score++
+		}
+
+		if r.Placement != "" {
+			if r.Placement != imp.TagID {
+				continue
+			}
+			score++
+		}
+
+		if r.Format != "" {
+			if !imp.HasFormat(r.Format) {
+				continue
+			}
+			score++
+		}
+
+		// country case is ignored as in dsp targeting
+		if r.Country != "" {
+			if !strings.EqualFold(r.Country, req.GetCountry()) {
+				continue
+			}
+			score++
+		}
+
+		if r.DeviceType != 0 {
+			if req.Device == nil || r.DeviceType != req.Device.DeviceType {
+				continue
+			}
+			score++
+		}
+
+		if score > best || (score == best && r.Floor > floor) {
+			best = score
+			floor = r.Floor
+			ok = true
+		}
+	}
+
+	return
+}
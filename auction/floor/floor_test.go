@@ -0,0 +1,77 @@
+package floor
+
+import (
+	"airpush/auction/openrtb"
+	"math"
+	"testing"
+)
+
+// banner request of publisher from country on device type
+func request(publisher, country string, deviceType int, floor float64) *openrtb.BidRequest {
+	return &openrtb.BidRequest{
+		Site: &openrtb.Site{Publisher: &openrtb.Publisher{ID: publisher}},
+		Device: &openrtb.Device{Geo: &openrtb.Geo{Country: country}, DeviceType: deviceType},
+		Imp: []openrtb.Imp{{ID: "1", TagID: "top", Banner: &openrtb.Banner{}, BidFloor: floor}},
+	}
+}
+
+func TestApply(t *testing.T) {
+
+	cases := []struct {
+		name string
+		rules []Rule
+		req *openrtb.BidRequest
+		floor float64
+	}{
+		{"no rules keep request floor", nil, request("p1", "USA", 1, 0.5), 0.5},
+		{"catch all rule", []Rule{{Floor: 1}}, request("p1", "USA", 1, 0), 1},
+		{"rule below request floor", []Rule{{Floor: 1}}, request("p1", "USA", 1, 2), 2},
+		{"not matched rule", []Rule{{Publisher: "p2", Floor: 1}}, request("p1", "USA", 1, 0), 0},
+		{"more specific win over higher", []Rule{
+			{Floor: 5}, {Publisher: "p1", Floor: 2}, {Publisher: "p1", Country: "USA", Floor: 1.5},
+		}, request("p1", "USA", 1, 0), 1.5},
+		{"equal specific higher win", []Rule{
+			{Publisher: "p1", Floor: 2}, {Country: "USA", Floor: 3}, {Format: openrtb.FORMAT_BANNER, Floor: 2.5},
+		}, request("p1", "USA", 1, 0), 3},
+		{"not matched specific ignored", []Rule{
+			{Floor: 1}, {Publisher: "p1", Format: openrtb.FORMAT_VIDEO, Floor: 4}, {Placement: "bottom", Floor: 4},
+		}, request("p1", "USA", 1, 0), 1},
+		{"country case ignored", []Rule{{Floor: 1}, {Country: "usa", Floor: 2}}, request("p1", "USA", 1, 0), 2},
+		{"device type", []Rule{
+			{Floor: 1}, {DeviceType: 4, Floor: 0.5}, {DeviceType: 1, Floor: 0.8},
+		}, request("p1", "USA", 4, 0), 0.5},
+		{"device type of request without device", []Rule{{DeviceType: 4, Floor: 0.5}}, &openrtb.BidRequest{
+			Imp: []openrtb.Imp{{ID: "1"}},
+		}, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			New(SetRules(c.rules)).Apply(c.req)
+			if floor := c.req.Imp[0].BidFloor; floor != c.floor {
+				t.Fatalf("floor %v, want %v", floor, c.floor)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+
+	cases := []struct {
+		floor float64
+		valid bool
+	}{
+		{0, true},
+		{1.5, true},
+		{-0.01, false},
+		{math.NaN(), false},
+		{math.Inf(1), false},
+	}
+
+	for _, c := range cases {
+		if err := Check([]Rule{{Floor: 1}, {Floor: c.floor}}); (err == nil) != c.valid {
+			t.Fatalf("floor %v valid %t, error %v", c.floor, c.valid, err)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package auction
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/event"
+	"airpush/auction/openrtb"
+	"time"
+)
+
+// write auction record to sink, skipped when sink is not set
+func (a *Auction) log(start time.Time, req *openrtb.BidRequest, skipped []event.DspRecord, bids []*bid.Bid, res *bid.AuctionResponse, err error) {
+
+	if a.events == nil {
+		return
+	}
+
+	r := &event.Record{
+		Type: event.TYPE_AUCTION,
+		ID: req.ID,
+		Time: start.UnixNano() / int64(time.Millisecond),
+		Duration: ms(time.Since(start)),
+		Result: auctionResult(res, err),
+		Dsp: skipped,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	if a.rates != nil {
+		r.Cur = a.rates.GetBase()
+	}
+
+	// invited dsps with every offer and its rejection reason
+	for _, b := range bids {
+
+		d := event.DspRecord{
+			Name: b.GetDsp().GetName(),
+			Status: b.GetStatus(),
+			Latency: ms(b.GetBuild()),
+			Error: b.GetErr(),
+		}
+
+		for _, o := range b.GetRes() {
+			br := event.BidRecord{
+				ImpID: o.Bid.ImpID,
+				ID: o.Bid.ID,
+				Seat: o.Seat,
+				Price: o.Bid.Price,
+				Cur: o.Cur,
+				Value: o.Value,
+				Reason: o.Reason,
+			}
+			if o.IsDeal() {
+				br.Deal = o.Deal.ID
+			}
+			d.Bids = append(d.Bids, br)
+		}
+
+		r.Dsp = append(r.Dsp, d)
+	}
+
+	// impression winners with clearing price
+	for i := range req.Imp {
+
+		imp := event.ImpRecord{
+			ImpID: req.Imp[i].ID,
+			Floor: req.Imp[i].BidFloor,
+		}
+
+		if res != nil {
+			for _, ir := range res.Imp {
+				if ir.ImpID != imp.ImpID || ir.Win == nil {
+					continue
+				}
+				imp.Winner = ir.Win.Dsp
+				imp.BidID = ir.Win.Bid.ID
+				imp.Price = ir.Win.Price
+				imp.Deal = ir.Deal
+			}
+		}
+
+		r.Imp = append(r.Imp, imp)
+	}
+
+	a.events.Write(r)
+}
+
+// duration in millisecond
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,245 @@
+package metrics
+
+import (
+	"io"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+// metric names prefix
+const NAMESPACE = "airpush"
+
+// auction results
+const RESULT_FILLED = "filled"
+const RESULT_EMPTY = "empty"
+const RESULT_ERROR = "error"
+
+// impression results
+const IMP_WIN = "win"
+const IMP_NO_FILL = "no_fill"
+
+// queues dropping work under backpressure
+const QUEUE_NOTICE = "notice"
+const QUEUE_EVENTS = "events"
+
+// text exposition format
+var FORMAT = expfmt.NewFormat(expfmt.TypeTextPlain)
+
+// price buckets in exchange currency
+var PRICE_BUCKETS = []float64{0.1, 0.25, 0.5, 1, 2, 3, 5, 7.5, 10, 15, 20, 30, 50, 75, 100}
+
+// latency buckets in second
+var LATENCY_BUCKETS = []float64{0.005, 0.01, 0.02, 0.03, 0.05, 0.075, 0.1, 0.15, 0.2, 0.3, 0.5, 1}
+
+// Metrics prometheus collectors of auction and dsp health
+// nil metrics is noop
+type Metrics struct {
+	registry *prometheus.Registry
+	auctions *prometheus.CounterVec
+	duration prometheus.Histogram
+	imps *prometheus.CounterVec
+	clearing prometheus.Histogram
+	requests *prometheus.CounterVec
+	responses *prometheus.CounterVec
+	latency *prometheus.HistogramVec
+	skipped *prometheus.CounterVec
+	bids *prometheus.CounterVec
+	rejected *prometheus.CounterVec
+	wins *prometheus.CounterVec
+	prices *prometheus.HistogramVec
+	tracked *prometheus.CounterVec
+	billable *prometheus.CounterVec
+	revenue *prometheus.CounterVec
+}
+
+// init metrics with own registry
+func New() (proto *Metrics) {
+
+	proto = &Metrics{
+		registry: prometheus.NewRegistry(),
+		auctions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "auctions_total", Help: "Auctions by result.",
+		}, []string{"result"}),
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: NAMESPACE, Name: "auction_duration_seconds", Help: "Auction latency.", Buckets: LATENCY_BUCKETS,
+		}),
+		imps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "imps_total", Help: "Impressions by result, fill rate is win over total.",
+		}, []string{"result"}),
+		clearing: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: NAMESPACE, Name: "clearing_price", Help: "Clearing price of won impressions.", Buckets: PRICE_BUCKETS,
+		}),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_requests_total", Help: "Bid requests sent to dsp.",
+		}, []string{"dsp"}),
+		responses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_responses_total", Help: "Dsp calls by status ok, no_bid, error, timeout.",
+		}, []string{"dsp", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE, Name: "dsp_duration_seconds", Help: "Dsp call latency.", Buckets: LATENCY_BUCKETS,
+		}, []string{"dsp"}),
+		skipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_skipped_total", Help: "Dsp not invited to auction by reason.",
+		}, []string{"dsp", "reason"}),
+		bids: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_bids_total", Help: "Offers received from dsp.",
+		}, []string{"dsp"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_rejected_total", Help: "Offers of dsp rejected by reason.",
+		}, []string{"dsp", "reason"}),
+		wins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_wins_total", Help: "Won offers of dsp, win rate is wins over bids.",
+		}, []string{"dsp"}),
+		prices: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE, Name: "dsp_bid_price", Help: "Offer price of dsp in exchange currency.", Buckets: PRICE_BUCKETS,
+		}, []string{"dsp"}),
+		tracked: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "tracking_total", Help: "Tracking fires by kind imp, click, event and status.",
+		}, []string{"kind", "status"}),
+		billable: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_billable_imps_total", Help: "Rendered impressions of dsp, billable rate is billable over wins.",
+		}, []string{"dsp"}),
+		revenue: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: "dsp_billable_price_total", Help: "Clearing price sum of billable impressions in exchange currency.",
+		}, []string{"dsp"}),
+	}
+
+	proto.registry.MustRegister(
+		proto.auctions, proto.duration, proto.imps, proto.clearing,
+		proto.requests, proto.responses, proto.latency, proto.skipped,
+		proto.bids, proto.rejected, proto.wins, proto.prices,
+		proto.tracked, proto.billable, proto.revenue,
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+
+	return
+}
+
+// auction finished with result
+func (m *Metrics) Auction(result string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.auctions.WithLabelValues(result).Inc()
+	m.duration.Observe(duration.Seconds())
+}
+
+// impression decided, price is clearing price of win
+func (m *Metrics) Imp(result string, price float64) {
+	if m == nil {
+		return
+	}
+	m.imps.WithLabelValues(result).Inc()
+	if result == IMP_WIN {
+		m.clearing.Observe(price)
+	}
+}
+
+// dsp not invited
+func (m *Metrics) Skip(dsp, reason string) {
+	if m == nil {
+		return
+	}
+	m.skipped.WithLabelValues(dsp, reason).Inc()
+}
+
+// dsp call finished with status
+func (m *Metrics) Call(dsp, status string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(dsp).Inc()
+	m.responses.WithLabelValues(dsp, status).Inc()
+	m.latency.WithLabelValues(dsp).Observe(duration.Seconds())
+}
+
+// offer received, price in exchange currency
+func (m *Metrics) Bid(dsp string, price float64) {
+	if m == nil {
+		return
+	}
+	m.bids.WithLabelValues(dsp).Inc()
+	m.prices.WithLabelValues(dsp).Observe(price)
+}
+
+// offer rejected
+func (m *Metrics) Reject(dsp, reason string) {
+	if m == nil {
+		return
+	}
+	m.rejected.WithLabelValues(dsp, reason).Inc()
+}
+
+// offer won
+func (m *Metrics) Win(dsp string) {
+	if m == nil {
+		return
+	}
+	m.wins.WithLabelValues(dsp).Inc()
+}
+
+// tracking fire
+func (m *Metrics) Track(kind, status string) {
+	if m == nil {
+		return
+	}
+	m.tracked.WithLabelValues(kind, status).Inc()
+}
+
+// impression rendered, price is clearing price
+func (m *Metrics) Billable(dsp string, price float64) {
+	if m == nil {
+		return
+	}
+	m.billable.WithLabelValues(dsp).Inc()
+	m.revenue.WithLabelValues(dsp).Add(price)
+}
+
+// dropped and failed counters of queue read on scrape, nil counter is not exported
+func (m *Metrics) Queue(queue string, dropped, failed func() uint64) {
+	if m == nil {
+		return
+	}
+
+	counters := []struct {
+		name string
+		help string
+		fn func() uint64
+	}{
+		{"queue_dropped_total", "Work dropped on full queue of notices or events.", dropped},
+		{"queue_failed_total", "Work of queue not delivered after all retries.", failed},
+	}
+
+	for _, c := range counters {
+		if c.fn == nil {
+			continue
+		}
+		fn := c.fn
+		m.registry.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: NAMESPACE, Name: c.name, Help: c.help, ConstLabels: prometheus.Labels{"queue": queue},
+		}, func() float64 {
+			return float64(fn())
+		}))
+	}
+}
+
+// write metrics in prometheus text format
+func (m *Metrics) Write(w io.Writer) error {
+
+	families, err := m.registry.Gather()
+	if err != nil {
+		return err
+	}
+
+	enc := expfmt.NewEncoder(w, FORMAT)
+	for _, f := range families {
+		if err = enc.Encode(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package native
+
+import "encoding/json"
+
+// supported native version
+const VERSION = "1.2"
+
+// image asset types
+const IMAGE_TYPE_ICON = 1
+const IMAGE_TYPE_MAIN = 3
+
+// event tracking types
+const EVENT_IMPRESSION = 1
+const EVENT_VIEWABLE_MRC50 = 2
+const EVENT_VIEWABLE_MRC100 = 3
+const EVENT_VIEWABLE_VIDEO50 = 4
+
+// event tracking methods
+const METHOD_IMG = 1
+const METHOD_JS = 2
+
+// RequestEnvelope native 1.0/1.1 wrapper of request
+type RequestEnvelope struct {
+	Native *Request `json:"native"`
+}
+
+// Request native markup request, encoded into imp.native.request
+type Request struct {
+	Ver            string                `json:"ver,omitempty"`
+	Context        int                   `json:"context,omitempty"`
+	ContextSubType int                   `json:"contextsubtype,omitempty"`
+	PlcmtType      int                   `json:"plcmttype,omitempty"`
+	PlcmtCnt       int                   `json:"plcmtcnt,omitempty"`
+	Seq            int                   `json:"seq,omitempty"`
+	Assets         []Asset               `json:"assets"`
+	AURLSupport    int                   `json:"aurlsupport,omitempty"`
+	DURLSupport    int                   `json:"durlsupport,omitempty"`
+	EventTrackers  []EventTrackerRequest `json:"eventtrackers,omitempty"`
+	Privacy        int                   `json:"privacy,omitempty"`
+	Ext            json.RawMessage       `json:"ext,omitempty"`
+}
+
+// Asset single element of native ad, only one of title, img, video, data is set
+type Asset struct {
+	ID       int             `json:"id"`
+	Required int             `json:"required,omitempty"`
+	Title    *Title          `json:"title,omitempty"`
+	Img      *Image          `json:"img,omitempty"`
+	Video    *Video          `json:"video,omitempty"`
+	Data     *Data           `json:"data,omitempty"`
+	Ext      json.RawMessage `json:"ext,omitempty"`
+}
+
+// Title asset, len is max length of text
+type Title struct {
+	Len int             `json:"len"`
+	Ext json.RawMessage `json:"ext,omitempty"`
+}
+
+// Image asset, w/h exact size or wmin/hmin minimal size
+type Image struct {
+	Type  int             `json:"type,omitempty"`
+	W     int             `json:"w,omitempty"`
+	WMin  int             `json:"wmin,omitempty"`
+	H     int             `json:"h,omitempty"`
+	HMin  int             `json:"hmin,omitempty"`
+	MIMEs []string        `json:"mimes,omitempty"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
+
+// Video asset
+type Video struct {
+	MIMEs       []string        `json:"mimes"`
+	MinDuration int             `json:"minduration"`
+	MaxDuration int             `json:"maxduration"`
+	Protocols   []int           `json:"protocols"`
+	Ext         json.RawMessage `json:"ext,omitempty"`
+}
+
+// Data asset, len is max length of value
+type Data struct {
+	Type int             `json:"type"`
+	Len  int             `json:"len,omitempty"`
+	Ext  json.RawMessage `json:"ext,omitempty"`
+}
+
+// EventTrackerRequest event and methods of tracking supported by publisher
+type EventTrackerRequest struct {
+	Event   int             `json:"event"`
+	Methods []int           `json:"methods"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// is asset required
+func (a *Asset) IsRequired() bool {
+	return a.Required == 1
+}
+
+// find asset by id
+func (r *Request) GetAsset(id int) *Asset {
+	for i := range r.Assets {
+		if r.Assets[i].ID == id {
+			return &r.Assets[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1079 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package native
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3c9d2b01DecodeAirpushAuctionNative(in *jlexer.Lexer, out *Video) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mimes":
+			if in.IsNull() {
+				in.Skip()
+				out.MIMEs = nil
+			} else {
+				in.Delim('[')
+				if out.MIMEs == nil {
+					if !in.IsDelim(']') {
+						out.MIMEs = make([]string, 0, 4)
+					} else {
+						out.MIMEs = []string{}
+					}
+				} else {
+					out.MIMEs = (out.MIMEs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.MIMEs = append(out.MIMEs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "minduration":
+			out.MinDuration = int(in.Int())
+		case "maxduration":
+			out.MaxDuration = int(in.Int())
+		case "protocols":
+			if in.IsNull() {
+				in.Skip()
+				out.Protocols = nil
+			} else {
+				in.Delim('[')
+				if out.Protocols == nil {
+					if !in.IsDelim(']') {
+						out.Protocols = make([]int, 0, 8)
+					} else {
+						out.Protocols = []int{}
+					}
+				} else {
+					out.Protocols = (out.Protocols)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 int
+					v2 = int(in.Int())
+					out.Protocols = append(out.Protocols, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative(out *jwriter.Writer, in Video) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mimes\":"
+		out.RawString(prefix[1:])
+		if in.MIMEs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v3, v4 := range in.MIMEs {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v4))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"minduration\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinDuration))
+	}
+	{
+		const prefix string = ",\"maxduration\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxDuration))
+	}
+	{
+		const prefix string = ",\"protocols\":"
+		out.RawString(prefix)
+		if in.Protocols == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Protocols {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Video) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Video) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Video) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Video) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative1(in *jlexer.Lexer, out *Title) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "len":
+			out.Len = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative1(out *jwriter.Writer, in Title) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"len\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Len))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Title) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Title) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Title) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Title) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative1(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative2(in *jlexer.Lexer, out *RequestEnvelope) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "native":
+			if in.IsNull() {
+				in.Skip()
+				out.Native = nil
+			} else {
+				if out.Native == nil {
+					out.Native = new(Request)
+				}
+				(*out.Native).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative2(out *jwriter.Writer, in RequestEnvelope) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"native\":"
+		out.RawString(prefix[1:])
+		if in.Native == nil {
+			out.RawString("null")
+		} else {
+			(*in.Native).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RequestEnvelope) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RequestEnvelope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RequestEnvelope) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RequestEnvelope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative2(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative3(in *jlexer.Lexer, out *Request) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ver":
+			out.Ver = string(in.String())
+		case "context":
+			out.Context = int(in.Int())
+		case "contextsubtype":
+			out.ContextSubType = int(in.Int())
+		case "plcmttype":
+			out.PlcmtType = int(in.Int())
+		case "plcmtcnt":
+			out.PlcmtCnt = int(in.Int())
+		case "seq":
+			out.Seq = int(in.Int())
+		case "assets":
+			if in.IsNull() {
+				in.Skip()
+				out.Assets = nil
+			} else {
+				in.Delim('[')
+				if out.Assets == nil {
+					if !in.IsDelim(']') {
+						out.Assets = make([]Asset, 0, 0)
+					} else {
+						out.Assets = []Asset{}
+					}
+				} else {
+					out.Assets = (out.Assets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 Asset
+					(v7).UnmarshalEasyJSON(in)
+					out.Assets = append(out.Assets, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "aurlsupport":
+			out.AURLSupport = int(in.Int())
+		case "durlsupport":
+			out.DURLSupport = int(in.Int())
+		case "eventtrackers":
+			if in.IsNull() {
+				in.Skip()
+				out.EventTrackers = nil
+			} else {
+				in.Delim('[')
+				if out.EventTrackers == nil {
+					if !in.IsDelim(']') {
+						out.EventTrackers = make([]EventTrackerRequest, 0, 1)
+					} else {
+						out.EventTrackers = []EventTrackerRequest{}
+					}
+				} else {
+					out.EventTrackers = (out.EventTrackers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 EventTrackerRequest
+					(v8).UnmarshalEasyJSON(in)
+					out.EventTrackers = append(out.EventTrackers, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "privacy":
+			out.Privacy = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative3(out *jwriter.Writer, in Request) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Ver != "" {
+		const prefix string = ",\"ver\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Ver))
+	}
+	if in.Context != 0 {
+		const prefix string = ",\"context\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Context))
+	}
+	if in.ContextSubType != 0 {
+		const prefix string = ",\"contextsubtype\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ContextSubType))
+	}
+	if in.PlcmtType != 0 {
+		const prefix string = ",\"plcmttype\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.PlcmtType))
+	}
+	if in.PlcmtCnt != 0 {
+		const prefix string = ",\"plcmtcnt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.PlcmtCnt))
+	}
+	if in.Seq != 0 {
+		const prefix string = ",\"seq\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Seq))
+	}
+	{
+		const prefix string = ",\"assets\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Assets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Assets {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.AURLSupport != 0 {
+		const prefix string = ",\"aurlsupport\":"
+		out.RawString(prefix)
+		out.Int(int(in.AURLSupport))
+	}
+	if in.DURLSupport != 0 {
+		const prefix string = ",\"durlsupport\":"
+		out.RawString(prefix)
+		out.Int(int(in.DURLSupport))
+	}
+	if len(in.EventTrackers) != 0 {
+		const prefix string = ",\"eventtrackers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.EventTrackers {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Privacy != 0 {
+		const prefix string = ",\"privacy\":"
+		out.RawString(prefix)
+		out.Int(int(in.Privacy))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Request) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Request) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Request) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Request) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative3(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative4(in *jlexer.Lexer, out *Image) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = int(in.Int())
+		case "w":
+			out.W = int(in.Int())
+		case "wmin":
+			out.WMin = int(in.Int())
+		case "h":
+			out.H = int(in.Int())
+		case "hmin":
+			out.HMin = int(in.Int())
+		case "mimes":
+			if in.IsNull() {
+				in.Skip()
+				out.MIMEs = nil
+			} else {
+				in.Delim('[')
+				if out.MIMEs == nil {
+					if !in.IsDelim(']') {
+						out.MIMEs = make([]string, 0, 4)
+					} else {
+						out.MIMEs = []string{}
+					}
+				} else {
+					out.MIMEs = (out.MIMEs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.MIMEs = append(out.MIMEs, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative4(out *jwriter.Writer, in Image) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Type != 0 {
+		const prefix string = ",\"type\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Type))
+	}
+	if in.W != 0 {
+		const prefix string = ",\"w\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.W))
+	}
+	if in.WMin != 0 {
+		const prefix string = ",\"wmin\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.WMin))
+	}
+	if in.H != 0 {
+		const prefix string = ",\"h\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.H))
+	}
+	if in.HMin != 0 {
+		const prefix string = ",\"hmin\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.HMin))
+	}
+	if len(in.MIMEs) != 0 {
+		const prefix string = ",\"mimes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.MIMEs {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Image) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Image) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Image) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Image) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative4(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative5(in *jlexer.Lexer, out *EventTrackerRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event":
+			out.Event = int(in.Int())
+		case "methods":
+			if in.IsNull() {
+				in.Skip()
+				out.Methods = nil
+			} else {
+				in.Delim('[')
+				if out.Methods == nil {
+					if !in.IsDelim(']') {
+						out.Methods = make([]int, 0, 8)
+					} else {
+						out.Methods = []int{}
+					}
+				} else {
+					out.Methods = (out.Methods)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 int
+					v16 = int(in.Int())
+					out.Methods = append(out.Methods, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative5(out *jwriter.Writer, in EventTrackerRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Event))
+	}
+	{
+		const prefix string = ",\"methods\":"
+		out.RawString(prefix)
+		if in.Methods == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Methods {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EventTrackerRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EventTrackerRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EventTrackerRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EventTrackerRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative5(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative6(in *jlexer.Lexer, out *Data) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = int(in.Int())
+		case "len":
+			out.Len = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative6(out *jwriter.Writer, in Data) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Type))
+	}
+	if in.Len != 0 {
+		const prefix string = ",\"len\":"
+		out.RawString(prefix)
+		out.Int(int(in.Len))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Data) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Data) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Data) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Data) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative6(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionNative7(in *jlexer.Lexer, out *Asset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "required":
+			out.Required = int(in.Int())
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+				out.Title = nil
+			} else {
+				if out.Title == nil {
+					out.Title = new(Title)
+				}
+				(*out.Title).UnmarshalEasyJSON(in)
+			}
+		case "img":
+			if in.IsNull() {
+				in.Skip()
+				out.Img = nil
+			} else {
+				if out.Img == nil {
+					out.Img = new(Image)
+				}
+				(*out.Img).UnmarshalEasyJSON(in)
+			}
+		case "video":
+			if in.IsNull() {
+				in.Skip()
+				out.Video = nil
+			} else {
+				if out.Video == nil {
+					out.Video = new(Video)
+				}
+				(*out.Video).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				if out.Data == nil {
+					out.Data = new(Data)
+				}
+				(*out.Data).UnmarshalEasyJSON(in)
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionNative7(out *jwriter.Writer, in Asset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.Required != 0 {
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Int(int(in.Required))
+	}
+	if in.Title != nil {
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		(*in.Title).MarshalEasyJSON(out)
+	}
+	if in.Img != nil {
+		const prefix string = ",\"img\":"
+		out.RawString(prefix)
+		(*in.Img).MarshalEasyJSON(out)
+	}
+	if in.Video != nil {
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		(*in.Video).MarshalEasyJSON(out)
+	}
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(*in.Data).MarshalEasyJSON(out)
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Asset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionNative7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Asset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionNative7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Asset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionNative7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Asset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionNative7(l, v)
+}
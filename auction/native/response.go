@@ -0,0 +1,90 @@
+package native
+
+import "encoding/json"
+
+// ResponseEnvelope native 1.0/1.1 wrapper of response
+type ResponseEnvelope struct {
+	Native *Response `json:"native"`
+}
+
+// Response native markup response, encoded into bid.adm
+type Response struct {
+	Ver           string          `json:"ver,omitempty"`
+	Assets        []ResponseAsset `json:"assets,omitempty"`
+	AssetsURL     string          `json:"assetsurl,omitempty"`
+	DCOURL        string          `json:"dcourl,omitempty"`
+	Link          *Link           `json:"link"`
+	ImpTrackers   []string        `json:"imptrackers,omitempty"`
+	JSTracker     string          `json:"jstracker,omitempty"`
+	EventTrackers []EventTracker  `json:"eventtrackers,omitempty"`
+	Privacy       string          `json:"privacy,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// ResponseAsset filled asset of request
+type ResponseAsset struct {
+	ID       int             `json:"id"`
+	Required int             `json:"required,omitempty"`
+	Title    *TitleResponse  `json:"title,omitempty"`
+	Img      *ImageResponse  `json:"img,omitempty"`
+	Video    *VideoResponse  `json:"video,omitempty"`
+	Data     *DataResponse   `json:"data,omitempty"`
+	Link     *Link           `json:"link,omitempty"`
+	Ext      json.RawMessage `json:"ext,omitempty"`
+}
+
+// TitleResponse title text
+type TitleResponse struct {
+	Text string          `json:"text"`
+	Len  int             `json:"len,omitempty"`
+	Ext  json.RawMessage `json:"ext,omitempty"`
+}
+
+// ImageResponse image url and size
+type ImageResponse struct {
+	Type int             `json:"type,omitempty"`
+	URL  string          `json:"url"`
+	W    int             `json:"w,omitempty"`
+	H    int             `json:"h,omitempty"`
+	Ext  json.RawMessage `json:"ext,omitempty"`
+}
+
+// VideoResponse vast of video asset
+type VideoResponse struct {
+	VASTTag string          `json:"vasttag"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// DataResponse data value
+type DataResponse struct {
+	Type  int             `json:"type,omitempty"`
+	Len   int             `json:"len,omitempty"`
+	Value string          `json:"value"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
+
+// Link click destination
+type Link struct {
+	URL           string          `json:"url"`
+	ClickTrackers []string        `json:"clicktrackers,omitempty"`
+	Fallback      string          `json:"fallback,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// EventTracker tracker of response
+type EventTracker struct {
+	Event  int             `json:"event"`
+	Method int             `json:"method"`
+	URL    string          `json:"url,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// find asset by id
+func (r *Response) GetAsset(id int) *ResponseAsset {
+	for i := range r.Assets {
+		if r.Assets[i].ID == id {
+			return &r.Assets[i]
+		}
+	}
+	return nil
+}
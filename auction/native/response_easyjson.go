@@ -0,0 +1,1075 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package native
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson6ff3ac1dDecodeAirpushAuctionNative(in *jlexer.Lexer, out *VideoResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "vasttag":
+			out.VASTTag = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative(out *jwriter.Writer, in VideoResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"vasttag\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.VASTTag))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VideoResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VideoResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VideoResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VideoResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative1(in *jlexer.Lexer, out *TitleResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "len":
+			out.Len = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative1(out *jwriter.Writer, in TitleResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	if in.Len != 0 {
+		const prefix string = ",\"len\":"
+		out.RawString(prefix)
+		out.Int(int(in.Len))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TitleResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TitleResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TitleResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TitleResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative1(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative2(in *jlexer.Lexer, out *ResponseEnvelope) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "native":
+			if in.IsNull() {
+				in.Skip()
+				out.Native = nil
+			} else {
+				if out.Native == nil {
+					out.Native = new(Response)
+				}
+				(*out.Native).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative2(out *jwriter.Writer, in ResponseEnvelope) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"native\":"
+		out.RawString(prefix[1:])
+		if in.Native == nil {
+			out.RawString("null")
+		} else {
+			(*in.Native).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseEnvelope) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseEnvelope) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseEnvelope) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseEnvelope) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative2(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative3(in *jlexer.Lexer, out *ResponseAsset) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "required":
+			out.Required = int(in.Int())
+		case "title":
+			if in.IsNull() {
+				in.Skip()
+				out.Title = nil
+			} else {
+				if out.Title == nil {
+					out.Title = new(TitleResponse)
+				}
+				(*out.Title).UnmarshalEasyJSON(in)
+			}
+		case "img":
+			if in.IsNull() {
+				in.Skip()
+				out.Img = nil
+			} else {
+				if out.Img == nil {
+					out.Img = new(ImageResponse)
+				}
+				(*out.Img).UnmarshalEasyJSON(in)
+			}
+		case "video":
+			if in.IsNull() {
+				in.Skip()
+				out.Video = nil
+			} else {
+				if out.Video == nil {
+					out.Video = new(VideoResponse)
+				}
+				(*out.Video).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				if out.Data == nil {
+					out.Data = new(DataResponse)
+				}
+				(*out.Data).UnmarshalEasyJSON(in)
+			}
+		case "link":
+			if in.IsNull() {
+				in.Skip()
+				out.Link = nil
+			} else {
+				if out.Link == nil {
+					out.Link = new(Link)
+				}
+				(*out.Link).UnmarshalEasyJSON(in)
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative3(out *jwriter.Writer, in ResponseAsset) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	if in.Required != 0 {
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Int(int(in.Required))
+	}
+	if in.Title != nil {
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		(*in.Title).MarshalEasyJSON(out)
+	}
+	if in.Img != nil {
+		const prefix string = ",\"img\":"
+		out.RawString(prefix)
+		(*in.Img).MarshalEasyJSON(out)
+	}
+	if in.Video != nil {
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		(*in.Video).MarshalEasyJSON(out)
+	}
+	if in.Data != nil {
+		const prefix string = ",\"data\":"
+		out.RawString(prefix)
+		(*in.Data).MarshalEasyJSON(out)
+	}
+	if in.Link != nil {
+		const prefix string = ",\"link\":"
+		out.RawString(prefix)
+		(*in.Link).MarshalEasyJSON(out)
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResponseAsset) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResponseAsset) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResponseAsset) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResponseAsset) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative3(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative4(in *jlexer.Lexer, out *Response) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ver":
+			out.Ver = string(in.String())
+		case "assets":
+			if in.IsNull() {
+				in.Skip()
+				out.Assets = nil
+			} else {
+				in.Delim('[')
+				if out.Assets == nil {
+					if !in.IsDelim(']') {
+						out.Assets = make([]ResponseAsset, 0, 0)
+					} else {
+						out.Assets = []ResponseAsset{}
+					}
+				} else {
+					out.Assets = (out.Assets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ResponseAsset
+					(v1).UnmarshalEasyJSON(in)
+					out.Assets = append(out.Assets, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "assetsurl":
+			out.AssetsURL = string(in.String())
+		case "dcourl":
+			out.DCOURL = string(in.String())
+		case "link":
+			if in.IsNull() {
+				in.Skip()
+				out.Link = nil
+			} else {
+				if out.Link == nil {
+					out.Link = new(Link)
+				}
+				(*out.Link).UnmarshalEasyJSON(in)
+			}
+		case "imptrackers":
+			if in.IsNull() {
+				in.Skip()
+				out.ImpTrackers = nil
+			} else {
+				in.Delim('[')
+				if out.ImpTrackers == nil {
+					if !in.IsDelim(']') {
+						out.ImpTrackers = make([]string, 0, 4)
+					} else {
+						out.ImpTrackers = []string{}
+					}
+				} else {
+					out.ImpTrackers = (out.ImpTrackers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 string
+					v2 = string(in.String())
+					out.ImpTrackers = append(out.ImpTrackers, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "jstracker":
+			out.JSTracker = string(in.String())
+		case "eventtrackers":
+			if in.IsNull() {
+				in.Skip()
+				out.EventTrackers = nil
+			} else {
+				in.Delim('[')
+				if out.EventTrackers == nil {
+					if !in.IsDelim(']') {
+						out.EventTrackers = make([]EventTracker, 0, 1)
+					} else {
+						out.EventTrackers = []EventTracker{}
+					}
+				} else {
+					out.EventTrackers = (out.EventTrackers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 EventTracker
+					(v3).UnmarshalEasyJSON(in)
+					out.EventTrackers = append(out.EventTrackers, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "privacy":
+			out.Privacy = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative4(out *jwriter.Writer, in Response) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Ver != "" {
+		const prefix string = ",\"ver\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Ver))
+	}
+	if len(in.Assets) != 0 {
+		const prefix string = ",\"assets\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v4, v5 := range in.Assets {
+				if v4 > 0 {
+					out.RawByte(',')
+				}
+				(v5).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.AssetsURL != "" {
+		const prefix string = ",\"assetsurl\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.AssetsURL))
+	}
+	if in.DCOURL != "" {
+		const prefix string = ",\"dcourl\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DCOURL))
+	}
+	{
+		const prefix string = ",\"link\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Link == nil {
+			out.RawString("null")
+		} else {
+			(*in.Link).MarshalEasyJSON(out)
+		}
+	}
+	if len(in.ImpTrackers) != 0 {
+		const prefix string = ",\"imptrackers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.ImpTrackers {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v7))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.JSTracker != "" {
+		const prefix string = ",\"jstracker\":"
+		out.RawString(prefix)
+		out.String(string(in.JSTracker))
+	}
+	if len(in.EventTrackers) != 0 {
+		const prefix string = ",\"eventtrackers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.EventTrackers {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Privacy != "" {
+		const prefix string = ",\"privacy\":"
+		out.RawString(prefix)
+		out.String(string(in.Privacy))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Response) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Response) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative4(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative5(in *jlexer.Lexer, out *Link) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "url":
+			out.URL = string(in.String())
+		case "clicktrackers":
+			if in.IsNull() {
+				in.Skip()
+				out.ClickTrackers = nil
+			} else {
+				in.Delim('[')
+				if out.ClickTrackers == nil {
+					if !in.IsDelim(']') {
+						out.ClickTrackers = make([]string, 0, 4)
+					} else {
+						out.ClickTrackers = []string{}
+					}
+				} else {
+					out.ClickTrackers = (out.ClickTrackers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 string
+					v10 = string(in.String())
+					out.ClickTrackers = append(out.ClickTrackers, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "fallback":
+			out.Fallback = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative5(out *jwriter.Writer, in Link) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.URL))
+	}
+	if len(in.ClickTrackers) != 0 {
+		const prefix string = ",\"clicktrackers\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.ClickTrackers {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Fallback != "" {
+		const prefix string = ",\"fallback\":"
+		out.RawString(prefix)
+		out.String(string(in.Fallback))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Link) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Link) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Link) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Link) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative5(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative6(in *jlexer.Lexer, out *ImageResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "w":
+			out.W = int(in.Int())
+		case "h":
+			out.H = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative6(out *jwriter.Writer, in ImageResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Type != 0 {
+		const prefix string = ",\"type\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Type))
+	}
+	{
+		const prefix string = ",\"url\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.URL))
+	}
+	if in.W != 0 {
+		const prefix string = ",\"w\":"
+		out.RawString(prefix)
+		out.Int(int(in.W))
+	}
+	if in.H != 0 {
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.Int(int(in.H))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative6(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative7(in *jlexer.Lexer, out *EventTracker) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "event":
+			out.Event = int(in.Int())
+		case "method":
+			out.Method = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative7(out *jwriter.Writer, in EventTracker) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"event\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Event))
+	}
+	{
+		const prefix string = ",\"method\":"
+		out.RawString(prefix)
+		out.Int(int(in.Method))
+	}
+	if in.URL != "" {
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EventTracker) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EventTracker) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EventTracker) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EventTracker) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative7(l, v)
+}
+func easyjson6ff3ac1dDecodeAirpushAuctionNative8(in *jlexer.Lexer, out *DataResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = int(in.Int())
+		case "len":
+			out.Len = int(in.Int())
+		case "value":
+			out.Value = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6ff3ac1dEncodeAirpushAuctionNative8(out *jwriter.Writer, in DataResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Type != 0 {
+		const prefix string = ",\"type\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.Type))
+	}
+	if in.Len != 0 {
+		const prefix string = ",\"len\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Len))
+	}
+	{
+		const prefix string = ",\"value\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Value))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DataResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6ff3ac1dEncodeAirpushAuctionNative8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DataResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6ff3ac1dEncodeAirpushAuctionNative8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DataResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6ff3ac1dDecodeAirpushAuctionNative8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DataResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6ff3ac1dDecodeAirpushAuctionNative8(l, v)
+}
@@ -0,0 +1,222 @@
+package native
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// parse native request, 1.2 object or 1.0/1.1 wrapped in native field
+func ParseRequest(s string) (*Request, error) {
+
+	env := new(RequestEnvelope)
+	err := env.UnmarshalJSON([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	if env.Native != nil {
+		return env.Native, nil
+	}
+
+	req := new(Request)
+	err = req.UnmarshalJSON([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// parse native response, 1.2 object or 1.0/1.1 wrapped in native field
+func ParseResponse(s string) (*Response, error) {
+
+	env := new(ResponseEnvelope)
+	err := env.UnmarshalJSON([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	if env.Native != nil {
+		return env.Native, nil
+	}
+
+	res := new(Response)
+	err = res.UnmarshalJSON([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// is markup native json
+func IsJSON(adm string) bool {
+	return strings.HasPrefix(strings.TrimSpace(adm), "{")
+}
+
+// validate native request against native 1.2 required fields
+func (r *Request) Validate() error {
+
+	if len(r.Assets) == 0 {
+		return fmt.Errorf("native request has no assets")
+	}
+
+	ids := make(map[int]bool, len(r.Assets))
+	for i := range r.Assets {
+
+		a := &r.Assets[i]
+		if ids[a.ID] {
+			return fmt.Errorf("native request has duplicate asset id %d", a.ID)
+		}
+		ids[a.ID] = true
+
+		if a.kinds() != 1 {
+			return fmt.Errorf("native asset %d must have exactly one of title, img, video, data", a.ID)
+		}
+
+		switch {
+		case a.Title != nil && a.Title.Len <= 0:
+			return fmt.Errorf("native asset %d title has no len", a.ID)
+		case a.Data != nil && a.Data.Type <= 0:
+			return fmt.Errorf("native asset %d data has no type", a.ID)
+		case a.Video != nil && len(a.Video.MIMEs) == 0:
+			return fmt.Errorf("native asset %d video has no mimes", a.ID)
+		}
+	}
+
+	for _, t := range r.EventTrackers {
+		if t.Event <= 0 || len(t.Methods) == 0 {
+			return fmt.Errorf("native event tracker has no event or methods")
+		}
+	}
+
+	return nil
+}
+
+// validate native response fill required assets of request with right types and sizes
+// required assets are not checked when response is served by assetsurl or dcourl
+func (res *Response) Validate(req *Request) error {
+
+	if res.Link == nil || res.Link.URL == "" {
+		return fmt.Errorf("native response has no link url")
+	}
+
+	for i := range res.Assets {
+
+		a := &res.Assets[i]
+		ra := req.GetAsset(a.ID)
+		if ra == nil {
+			return fmt.Errorf("native response asset %d not requested", a.ID)
+		}
+
+		if err := a.match(ra); err != nil {
+			return err
+		}
+	}
+
+	// assets served by assetsurl or dcourl are not known in advance
+	if res.AssetsURL == "" && res.DCOURL == "" {
+		for i := range req.Assets {
+			if ra := &req.Assets[i]; ra.IsRequired() && res.GetAsset(ra.ID) == nil {
+				return fmt.Errorf("native response miss required asset %d", ra.ID)
+			}
+		}
+	}
+
+	// only event trackers supported by publisher
+	if len(req.EventTrackers) > 0 {
+		for _, t := range res.EventTrackers {
+			if !req.supports(t.Event, t.Method) {
+				return fmt.Errorf("native response event tracker %d method %d not supported", t.Event, t.Method)
+			}
+		}
+	}
+
+	return nil
+}
+
+// response asset has type and size of requested asset
+func (a *ResponseAsset) match(ra *Asset) error {
+
+	switch {
+	case ra.Title != nil:
+		if a.Title == nil || a.Title.Text == "" {
+			return fmt.Errorf("native asset %d must be title", a.ID)
+		}
+		if utf8.RuneCountInString(a.Title.Text) > ra.Title.Len {
+			return fmt.Errorf("native asset %d title longer than %d", a.ID, ra.Title.Len)
+		}
+
+	case ra.Img != nil:
+		if a.Img == nil || a.Img.URL == "" {
+			return fmt.Errorf("native asset %d must be img", a.ID)
+		}
+		if !ra.Img.fits(a.Img.W, a.Img.H) {
+			return fmt.Errorf("native asset %d img size %dx%d not allowed", a.ID, a.Img.W, a.Img.H)
+		}
+
+	case ra.Video != nil:
+		if a.Video == nil || a.Video.VASTTag == "" {
+			return fmt.Errorf("native asset %d must be video", a.ID)
+		}
+
+	case ra.Data != nil:
+		if a.Data == nil || a.Data.Value == "" {
+			return fmt.Errorf("native asset %d must be data", a.ID)
+		}
+		if ra.Data.Len > 0 && utf8.RuneCountInString(a.Data.Value) > ra.Data.Len {
+			return fmt.Errorf("native asset %d data longer than %d", a.ID, ra.Data.Len)
+		}
+	}
+
+	return nil
+}
+
+// is image size allowed, unknown size pass
+func (img *Image) fits(w, h int) bool {
+
+	if w == 0 || h == 0 {
+		return true
+	}
+
+	if img.WMin > 0 || img.HMin > 0 {
+		return w >= img.WMin && h >= img.HMin
+	}
+
+	if img.W > 0 && img.H > 0 {
+		return w == img.W && h == img.H
+	}
+
+	return true
+}
+
+// count of asset kinds set
+func (a *Asset) kinds() (n int) {
+	if a.Title != nil {
+		n++
+	}
+	if a.Img != nil {
+		n++
+	}
+	if a.Video != nil {
+		n++
+	}
+	if a.Data != nil {
+		n++
+	}
+	return
+}
+
+// is event tracker requested by publisher
+func (r *Request) supports(event, method int) bool {
+	for _, t := range r.EventTrackers {
+		if t.Event != event {
+			continue
+		}
+		for _, m := range t.Methods {
+			if m == method {
+				return true
+			}
+		}
+	}
+	return false
+}
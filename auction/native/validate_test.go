@@ -0,0 +1,143 @@
+package native
+
+import (
+	"testing"
+)
+
+// native request of required title, optional image and required data
+const TEST_REQUEST = `{"ver":"1.2","assets":[` +
+	`{"id":1,"required":1,"title":{"len":10}},` +
+	`{"id":2,"img":{"type":3,"wmin":100,"hmin":50}},` +
+	`{"id":3,"required":1,"data":{"type":2,"len":5}}],` +
+	`"eventtrackers":[{"event":1,"methods":[1]}]}`
+
+func TestParseRequest(t *testing.T) {
+
+	cases := []struct {
+		name string
+		s string
+		assets int
+		valid bool
+	}{
+		{"native 1.2", `{"ver":"1.2","assets":[{"id":1,"title":{"len":10}}]}`, 1, true},
+		{"native 1.1 envelope", `{"native":{"ver":"1.1","assets":[{"id":1,"title":{"len":10}},{"id":2,"data":{"type":1}}]}}`, 2, true},
+		{"not json", `<div/>`, 0, false},
+		{"wrong type", `{"assets":"title"}`, 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			req, err := ParseRequest(c.s)
+			if (err == nil) != c.valid {
+				t.Fatalf("valid %t, error %v", c.valid, err)
+			}
+			if c.valid && len(req.Assets) != c.assets {
+				t.Fatalf("assets %d, want %d", len(req.Assets), c.assets)
+			}
+		})
+	}
+}
+
+func TestRequestValidate(t *testing.T) {
+
+	cases := []struct {
+		name string
+		s string
+		valid bool
+	}{
+		{"valid", TEST_REQUEST, true},
+		{"video asset", `{"assets":[{"id":1,"video":{"mimes":["video/mp4"]}}]}`, true},
+		{"no assets", `{"assets":[]}`, false},
+		{"duplicate asset id", `{"assets":[{"id":1,"title":{"len":10}},{"id":1,"data":{"type":1}}]}`, false},
+		{"asset without kind", `{"assets":[{"id":1}]}`, false},
+		{"asset of two kinds", `{"assets":[{"id":1,"title":{"len":10},"data":{"type":1}}]}`, false},
+		{"title without len", `{"assets":[{"id":1,"title":{"len":0}}]}`, false},
+		{"data without type", `{"assets":[{"id":1,"data":{"len":5}}]}`, false},
+		{"video without mimes", `{"assets":[{"id":1,"video":{"mimes":[]}}]}`, false},
+		{"event tracker without methods", `{"assets":[{"id":1,"title":{"len":10}}],"eventtrackers":[{"event":1,"methods":[]}]}`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			req, err := ParseRequest(c.s)
+			if err != nil {
+				t.Fatalf("parse: %s", err)
+			}
+
+			if err := req.Validate(); (err == nil) != c.valid {
+				t.Fatalf("valid %t, error %v", c.valid, err)
+			}
+		})
+	}
+}
+
+func TestResponseValidate(t *testing.T) {
+
+	req, err := ParseRequest(TEST_REQUEST)
+	if err != nil {
+		t.Fatalf("parse request: %s", err)
+	}
+
+	cases := []struct {
+		name string
+		s string
+		valid bool
+	}{
+		{"required assets", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":3,"data":{"value":"4.5"}}]}`, true},
+		{"native 1.1 envelope", `{"native":{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":3,"data":{"value":"4.5"}}]}}`, true},
+		{"optional image", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":2,"img":{"url":"http://ok.com/i.png","w":200,"h":100}},{"id":3,"data":{"value":"4.5"}}]}`, true},
+		{"assets url", `{"link":{"url":"http://ok.com"},"assetsurl":"http://dsp/assets"}`, true},
+		{"dco url", `{"link":{"url":"http://ok.com"},"dcourl":"http://dsp/dco"}`, true},
+		{"assets url with wrong inline asset", `{"link":{"url":"http://ok.com"},"assetsurl":"http://dsp/assets","assets":[{"id":1,"data":{"value":"x"}}]}`, false},
+		{"no link", `{"assets":[{"id":1,"title":{"text":"title"}},{"id":3,"data":{"value":"4.5"}}]}`, false},
+		{"miss required asset", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}}]}`, false},
+		{"not requested asset", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":3,"data":{"value":"4.5"}},{"id":9,"data":{"value":"x"}}]}`, false},
+		{"title too long", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title is too long"}},{"id":3,"data":{"value":"4.5"}}]}`, false},
+		{"wrong asset kind", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"data":{"value":"title"}},{"id":3,"data":{"value":"4.5"}}]}`, false},
+		{"image too small", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":2,"img":{"url":"http://ok.com/i.png","w":50,"h":50}},{"id":3,"data":{"value":"4.5"}}]}`, false},
+		{"data too long", `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":3,"data":{"value":"4.5 stars"}}]}`, false},
+		{"supported event tracker", `{"link":{"url":"http://ok.com"},"assetsurl":"http://dsp/assets","eventtrackers":[{"event":1,"method":1,"url":"http://dsp/imp"}]}`, true},
+		{"not supported event tracker", `{"link":{"url":"http://ok.com"},"assetsurl":"http://dsp/assets","eventtrackers":[{"event":1,"method":2,"url":"http://dsp/imp.js"}]}`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			res, err := ParseResponse(c.s)
+			if err != nil {
+				t.Fatalf("parse: %s", err)
+			}
+
+			if err := res.Validate(req); (err == nil) != c.valid {
+				t.Fatalf("valid %t, error %v", c.valid, err)
+			}
+		})
+	}
+}
+
+func TestFits(t *testing.T) {
+
+	cases := []struct {
+		name string
+		img Image
+		w, h int
+		fits bool
+	}{
+		{"unknown size", Image{W: 300, H: 250}, 0, 0, true},
+		{"exact size", Image{W: 300, H: 250}, 300, 250, true},
+		{"other size", Image{W: 300, H: 250}, 320, 250, false},
+		{"above min size", Image{WMin: 100, HMin: 50}, 200, 50, true},
+		{"below min height", Image{WMin: 100, HMin: 50}, 200, 49, false},
+		{"min size win over exact", Image{W: 300, H: 250, WMin: 100, HMin: 50}, 200, 100, true},
+		{"only min width", Image{WMin: 100}, 100, 1, true},
+		{"any size", Image{}, 1, 1, true},
+	}
+
+	for _, c := range cases {
+		if fits := c.img.fits(c.w, c.h); fits != c.fits {
+			t.Fatalf("%s: fits %t, want %t", c.name, fits, c.fits)
+		}
+	}
+}
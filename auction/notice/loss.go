@@ -0,0 +1,59 @@
+package notice
+
+import "airpush/auction/bid"
+
+// openrtb loss reason codes
+const LOSS_WON = 0
+const LOSS_INTERNAL_ERROR = 1
+const LOSS_INVALID_RESPONSE = 3
+const LOSS_INVALID_DEAL = 4
+const LOSS_MISSING_MARKUP = 7
+const LOSS_BELOW_FLOOR = 100
+const LOSS_BELOW_DEAL_FLOOR = 101
+const LOSS_LOST_TO_HIGHER = 102
+const LOSS_LOST_TO_DEAL = 103
+const LOSS_SEAT_BLOCKED = 104
+const LOSS_DISAPPROVED = 202
+const LOSS_SIZE_NOT_ALLOWED = 203
+const LOSS_INCORRECT_FORMAT = 204
+const LOSS_ADV_EXCLUSION = 205
+const LOSS_APP_EXCLUSION = 206
+const LOSS_CAT_EXCLUSION = 209
+const LOSS_ATTR_EXCLUSION = 210
+
+// loss code of rejected offer
+func LossCode(reason string) int {
+	switch reason {
+	case "":
+		return LOSS_LOST_TO_HIGHER
+	case bid.REASON_BELOW_FLOOR:
+		return LOSS_BELOW_FLOOR
+	case bid.REASON_UNKNOWN_IMP, bid.REASON_CURRENCY, bid.REASON_UNKNOWN_CURRENCY:
+		return LOSS_INVALID_RESPONSE
+	case bid.REASON_MISSING_MARKUP:
+		return LOSS_MISSING_MARKUP
+	case bid.REASON_BLOCKED_CREATIVE:
+		return LOSS_DISAPPROVED
+	case bid.REASON_INVALID_SIZE:
+		return LOSS_SIZE_NOT_ALLOWED
+	case bid.REASON_INVALID_VAST, bid.REASON_INVALID_NATIVE:
+		return LOSS_INCORRECT_FORMAT
+	case bid.REASON_BLOCKED_ADV:
+		return LOSS_ADV_EXCLUSION
+	case bid.REASON_BLOCKED_APP:
+		return LOSS_APP_EXCLUSION
+	case bid.REASON_BLOCKED_CAT:
+		return LOSS_CAT_EXCLUSION
+	case bid.REASON_BLOCKED_ATTR:
+		return LOSS_ATTR_EXCLUSION
+	case bid.REASON_UNKNOWN_DEAL:
+		return LOSS_INVALID_DEAL
+	case bid.REASON_BELOW_DEAL_FLOOR:
+		return LOSS_BELOW_DEAL_FLOOR
+	case bid.REASON_SEAT_BLOCKED:
+		return LOSS_SEAT_BLOCKED
+	case bid.REASON_PRIVATE_AUCTION:
+		return LOSS_LOST_TO_DEAL
+	}
+	return LOSS_INTERNAL_ERROR
+}
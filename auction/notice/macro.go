@@ -0,0 +1,73 @@
+package notice
+
+import (
+	"airpush/auction/price"
+	"strconv"
+	"strings"
+)
+
+// openrtb substitution macros
+const MACRO_AUCTION_ID = "${AUCTION_ID}"
+const MACRO_AUCTION_BID_ID = "${AUCTION_BID_ID}"
+const MACRO_AUCTION_IMP_ID = "${AUCTION_IMP_ID}"
+const MACRO_AUCTION_SEAT_ID = "${AUCTION_SEAT_ID}"
+const MACRO_AUCTION_AD_ID = "${AUCTION_AD_ID}"
+const MACRO_AUCTION_PRICE = "${AUCTION_PRICE}"
+const MACRO_AUCTION_CURRENCY = "${AUCTION_CURRENCY}"
+const MACRO_AUCTION_LOSS = "${AUCTION_LOSS}"
+const MACRO_AUCTION_MBR = "${AUCTION_MBR}"
+const MACRO_AUCTION_MIN_TO_WIN = "${AUCTION_MIN_TO_WIN}"
+
+// default currency of auction
+const DEFAULT_CURRENCY = "USD"
+
+// Macros values of single offer
+type Macros struct {
+	AuctionID string
+	BidID string
+	ImpID string
+	SeatID string
+	AdID string
+	Price float64
+	Currency string
+	Loss int
+	// clearing price to offer price ratio
+	MBR float64
+	// clearing price, lowest bid would have won
+	MinToWin float64
+	// encrypt price of dsp with keys, nil keep clear text
+	Crypter *price.Crypter
+}
+
+// replace macros in url or markup
+func (m *Macros) Substitute(s string) string {
+
+	// fast path, nothing to replace
+	if !strings.Contains(s, "${AUCTION_") {
+		return s
+	}
+
+	auctionPrice := strconv.FormatFloat(m.Price, 'f', -1, 64)
+	if m.Crypter != nil && strings.Contains(s, MACRO_AUCTION_PRICE) {
+		auctionPrice = m.Crypter.Encrypt(m.Price)
+	}
+
+	// min to win is clearing price, encrypted same way
+	minToWin := strconv.FormatFloat(m.MinToWin, 'f', -1, 64)
+	if m.Crypter != nil && strings.Contains(s, MACRO_AUCTION_MIN_TO_WIN) {
+		minToWin = m.Crypter.Encrypt(m.MinToWin)
+	}
+
+	return strings.NewReplacer(
+		MACRO_AUCTION_ID, m.AuctionID,
+		MACRO_AUCTION_BID_ID, m.BidID,
+		MACRO_AUCTION_IMP_ID, m.ImpID,
+		MACRO_AUCTION_SEAT_ID, m.SeatID,
+		MACRO_AUCTION_AD_ID, m.AdID,
+		MACRO_AUCTION_PRICE, auctionPrice,
+		MACRO_AUCTION_CURRENCY, m.Currency,
+		MACRO_AUCTION_LOSS, strconv.Itoa(m.Loss),
+		MACRO_AUCTION_MBR, strconv.FormatFloat(m.MBR, 'f', -1, 64),
+		MACRO_AUCTION_MIN_TO_WIN, minToWin,
+	).Replace(s)
+}
@@ -0,0 +1,61 @@
+package notice
+
+import (
+	"airpush/auction/price"
+	"strings"
+	"testing"
+)
+
+func TestSubstitute(t *testing.T) {
+
+	m := &Macros{
+		AuctionID: "a1",
+		BidID: "b1",
+		ImpID: "i1",
+		SeatID: "s1",
+		AdID: "ad1",
+		Price: 1.5,
+		Currency: "EUR",
+		Loss: LOSS_WON,
+		MBR: 0.75,
+		MinToWin: 1.5,
+	}
+
+	cases := map[string]string{
+		"http://dsp/win?id=${AUCTION_ID}&bid=${AUCTION_BID_ID}&imp=${AUCTION_IMP_ID}":
+			"http://dsp/win?id=a1&bid=b1&imp=i1",
+		"seat=${AUCTION_SEAT_ID}&ad=${AUCTION_AD_ID}&cur=${AUCTION_CURRENCY}":
+			"seat=s1&ad=ad1&cur=EUR",
+		"p=${AUCTION_PRICE}&mbr=${AUCTION_MBR}&min=${AUCTION_MIN_TO_WIN}":
+			"p=1.5&mbr=0.75&min=1.5",
+		"no macros": "no macros",
+	}
+
+	for in, out := range cases {
+		if got := m.Substitute(in); got != out {
+			t.Fatalf("substitute %q = %q, want %q", in, got, out)
+		}
+	}
+}
+
+func TestSubstituteEncrypted(t *testing.T) {
+
+	c, err := price.New(price.SetEncryptionKey([]byte("encryption")), price.SetIntegrityKey([]byte("integrity")))
+	if err != nil {
+		t.Fatalf("crypter: %s", err)
+	}
+
+	m := &Macros{Price: 2.5, MinToWin: 2.5, MBR: 0.5, Crypter: c}
+	got := strings.Split(m.Substitute("${AUCTION_PRICE}|${AUCTION_MIN_TO_WIN}|${AUCTION_MBR}"), "|")
+
+	for _, s := range got[:2] {
+		v, err := c.Decrypt(s)
+		if err != nil || v != 2.5 {
+			t.Fatalf("decrypt %q = %v, %v", s, v, err)
+		}
+	}
+
+	if got[2] != "0.5" {
+		t.Fatalf("mbr %q, want 0.5", got[2])
+	}
+}
@@ -0,0 +1,259 @@
+package notice
+
+import (
+	"airpush/auction/event"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaults
+const DEFAULT_WORKERS = 8
+const DEFAULT_QUEUE = 1024
+const DEFAULT_RETRIES = 2
+const DEFAULT_TIMEOUT = time.Duration(1000) * time.Millisecond
+const DEFAULT_BACKOFF = time.Duration(100) * time.Millisecond
+
+// notice kinds
+const KIND_WIN = "win"
+const KIND_LOSS = "loss"
+const KIND_BILLING = "billing"
+
+// notice states written to events
+const STATUS_SENT = "sent"
+const STATUS_FAILED = "failed"
+const STATUS_DROPPED = "dropped"
+
+// Notice url fired to dsp with auction it belong to
+type Notice struct {
+	Kind string
+	URL string
+	AuctionID string
+	ImpID string
+	Dsp string
+	Loss int
+}
+
+// logger interface
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// settings setter
+type NotifierOption func(*Notifier)
+
+// number of workers
+func SetWorkers(n int) NotifierOption {
+	return func(t *Notifier) {
+		if n > 0 {
+			t.workers = n
+		}
+	}
+}
+
+// max notices waiting to be fired, overflow is dropped
+func SetQueueSize(n int) NotifierOption {
+	return func(t *Notifier) {
+		if n > 0 {
+			t.size = n
+		}
+	}
+}
+
+// retries after first failed attempt
+func SetRetries(n int) NotifierOption {
+	return func(t *Notifier) {
+		if n >= 0 {
+			t.retries = n
+		}
+	}
+}
+
+// timeout of single attempt
+func SetTimeout(duration time.Duration) NotifierOption {
+	return func(t *Notifier) {
+		if duration > 0 {
+			t.timeout = duration
+		}
+	}
+}
+
+// logger
+func SetLogger(logger Logger) NotifierOption {
+	return func(t *Notifier) {
+		t.logger = logger
+	}
+}
+
+// notices sink, nil for disable
+func SetEvents(events event.Sink) NotifierOption {
+	return func(t *Notifier) {
+		t.events = events
+	}
+}
+
+// Notifier fire win/loss notices async by bounded worker pool
+type Notifier struct {
+	client *http.Client
+	queue chan Notice
+	wg sync.WaitGroup
+	workers int
+	size int
+	retries int
+	timeout time.Duration
+	dropped uint64
+	failed uint64
+	logger Logger
+	events event.Sink
+}
+
+// init notifier
+func New(opts ...NotifierOption) (proto *Notifier) {
+
+	proto = &Notifier{
+		client: &http.Client{},
+		workers: DEFAULT_WORKERS,
+		size: DEFAULT_QUEUE,
+		retries: DEFAULT_RETRIES,
+		timeout: DEFAULT_TIMEOUT,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	proto.queue = make(chan Notice, proto.size)
+
+	return
+}
+
+// run workers
+func (n *Notifier) Start() {
+	n.wg.Add(n.workers)
+	for i := 0; i < n.workers; i++ {
+		go n.work()
+	}
+}
+
+// stop accept notices, wait queued ones
+func (n *Notifier) Close() {
+	close(n.queue)
+	n.wg.Wait()
+}
+
+// queue notice, never block caller
+func (n *Notifier) Send(notice Notice) bool {
+
+	if notice.URL == "" {
+		return false
+	}
+
+	select {
+	case n.queue <- notice:
+		return true
+	default:
+		atomic.AddUint64(&n.dropped, 1)
+		n.log(notice, STATUS_DROPPED, 0, 0, nil)
+		return false
+	}
+}
+
+// count of dropped notices
+func (n *Notifier) GetDropped() uint64 {
+	return atomic.LoadUint64(&n.dropped)
+}
+
+// count of notices not fired after all retries
+func (n *Notifier) GetFailed() uint64 {
+	return atomic.LoadUint64(&n.failed)
+}
+
+// worker loop
+func (n *Notifier) work() {
+	defer n.wg.Done()
+
+	for notice := range n.queue {
+
+		start := time.Now()
+
+		var err error
+		attempt := 0
+		for ; attempt <= n.retries; attempt++ {
+
+			if attempt > 0 {
+				time.Sleep(time.Duration(attempt) * DEFAULT_BACKOFF)
+			}
+
+			if err = n.fire(notice.URL); err == nil {
+				break
+			}
+		}
+
+		if err != nil {
+			atomic.AddUint64(&n.failed, 1)
+			if n.logger != nil {
+				n.logger.Printf("notice %s fail: %s", notice.URL, err)
+			}
+			n.log(notice, STATUS_FAILED, attempt, time.Since(start), err)
+			continue
+		}
+
+		n.log(notice, STATUS_SENT, attempt+1, time.Since(start), nil)
+	}
+}
+
+// write notice outcome to events sink
+func (n *Notifier) log(notice Notice, status string, attempts int, duration time.Duration, err error) {
+
+	if n.events == nil {
+		return
+	}
+
+	e := &event.Notice{
+		Type: event.TYPE_NOTICE,
+		Time: time.Now().UnixNano() / int64(time.Millisecond),
+		Kind: notice.Kind,
+		AuctionID: notice.AuctionID,
+		ImpID: notice.ImpID,
+		Dsp: notice.Dsp,
+		Loss: notice.Loss,
+		URL: notice.URL,
+		Status: status,
+		Attempts: attempts,
+		Duration: float64(duration) / float64(time.Millisecond),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	n.events.Write(e)
+}
+
+// single attempt, client errors not retried
+func (n *Notifier) fire(url string) error {
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := n.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_ = res.Body.Close()
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status: %d", res.StatusCode)
+	}
+
+	return nil
+}
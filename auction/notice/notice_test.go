@@ -0,0 +1,35 @@
+package notice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNotifierCounts(t *testing.T) {
+
+	fail := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer fail.Close()
+
+	n := New(SetWorkers(1), SetQueueSize(1), SetRetries(0))
+
+	// no worker yet, second notice overflows queue
+	if !n.Send(Notice{URL: fail.URL}) {
+		t.Fatal("first notice not queued")
+	}
+	if n.Send(Notice{URL: fail.URL}) {
+		t.Fatal("notice queued over queue size")
+	}
+
+	n.Start()
+	n.Close()
+
+	if got := n.GetDropped(); got != 1 {
+		t.Errorf("dropped %d, want 1", got)
+	}
+	if got := n.GetFailed(); got != 1 {
+		t.Errorf("failed %d, want 1", got)
+	}
+}
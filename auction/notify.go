@@ -0,0 +1,97 @@
+package auction
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/notice"
+	"airpush/auction/openrtb"
+)
+
+// substitute macros in winners markup, fire nurl of winners and lurl of losers
+func (a *Auction) notify(req *openrtb.BidRequest, bids []*bid.Bid, res *bid.AuctionResponse) {
+
+	// winners and clearing price by impression
+	wins := make(map[*bid.RtbResponse]bool, len(res.Imp))
+	winners := make(map[string]*bid.RtbResponse, len(res.Imp))
+	prices := make(map[string]float64, len(res.Imp))
+	for _, imp := range res.Imp {
+		if imp.Win != nil {
+			wins[imp.Win] = true
+			winners[imp.ImpID] = imp.Win
+			prices[imp.ImpID] = imp.Win.Price
+		}
+	}
+
+	for _, b := range bids {
+		for _, r := range b.GetRes() {
+
+			m := &notice.Macros{
+				AuctionID: req.ID,
+				BidID: r.BidID,
+				ImpID: r.Bid.ImpID,
+				SeatID: r.Seat,
+				AdID: r.Bid.AdID,
+				Price: prices[r.Bid.ImpID],
+				Currency: notice.DEFAULT_CURRENCY,
+			}
+			if d := b.GetDsp(); d != nil {
+				m.Crypter = d.GetCrypter()
+			}
+
+			// ratio in exchange currency, same in any currency
+			if m.Price > 0 && r.Value > 0 {
+				m.MBR = m.Price / r.Value
+			}
+
+			// price reported in currency of offer
+			if a.rates != nil {
+				m.Currency = a.rates.GetBase()
+				if price, ok := a.rates.FromBase(m.Price, r.Cur); ok {
+					m.Price = price
+					m.Currency = bidCur(r)
+				}
+			}
+			m.MinToWin = m.Price
+
+			if wins[r] {
+				m.Loss = notice.LOSS_WON
+				r.Bid.AdM = m.Substitute(r.Bid.AdM)
+				r.Bid.NURL = m.Substitute(r.Bid.NURL)
+				r.Bid.BURL = m.Substitute(r.Bid.BURL)
+				if a.notifier != nil {
+					a.notifier.Send(notice.Notice{
+						Kind: notice.KIND_WIN,
+						URL: r.Bid.NURL,
+						AuctionID: req.ID,
+						ImpID: r.Bid.ImpID,
+						Dsp: r.Dsp,
+					})
+				}
+				continue
+			}
+
+			m.Loss = notice.LossCode(r.Reason)
+			if w := winners[r.Bid.ImpID]; r.IsValid() && w != nil && w.IsDeal() && !r.IsDeal() {
+				m.Loss = notice.LOSS_LOST_TO_DEAL
+			}
+			r.Bid.LURL = m.Substitute(r.Bid.LURL)
+			if a.notifier != nil {
+				a.notifier.Send(notice.Notice{
+					Kind: notice.KIND_LOSS,
+					URL: r.Bid.LURL,
+					AuctionID: req.ID,
+					ImpID: r.Bid.ImpID,
+					Dsp: r.Dsp,
+					Loss: m.Loss,
+				})
+			}
+		}
+	}
+}
+
+// currency of offer, empty is openrtb default
+func bidCur(r *bid.RtbResponse) string {
+	if r.Cur == "" {
+		return openrtb.DEFAULT_CURRENCY
+	}
+	return r.Cur
+}
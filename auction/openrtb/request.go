@@ -0,0 +1,468 @@
+package openrtb
+
+import "encoding/json"
+
+// supported protocol version
+const VERSION = "2.5"
+
+// currency when cur is omitted
+const DEFAULT_CURRENCY = "USD"
+
+// auction types
+const AUCTION_TYPE_FIRST_PRICE = 1
+const AUCTION_TYPE_SECOND_PRICE = 2
+const AUCTION_TYPE_FIXED_PRICE = 3
+
+// impression formats
+const FORMAT_BANNER = "banner"
+const FORMAT_VIDEO = "video"
+const FORMAT_AUDIO = "audio"
+const FORMAT_NATIVE = "native"
+
+// BidRequest top-level object
+type BidRequest struct {
+	ID      string          `json:"id"`
+	Imp     []Imp           `json:"imp"`
+	Site    *Site           `json:"site,omitempty"`
+	App     *App            `json:"app,omitempty"`
+	Device  *Device         `json:"device,omitempty"`
+	User    *User           `json:"user,omitempty"`
+	Test    int             `json:"test,omitempty"`
+	AT      int             `json:"at,omitempty"`
+	TMax    int             `json:"tmax,omitempty"`
+	WSeat   []string        `json:"wseat,omitempty"`
+	BSeat   []string        `json:"bseat,omitempty"`
+	AllImps int             `json:"allimps,omitempty"`
+	Cur     []string        `json:"cur,omitempty"`
+	WLang   []string        `json:"wlang,omitempty"`
+	BCat    []string        `json:"bcat,omitempty"`
+	BAdv    []string        `json:"badv,omitempty"`
+	BApp    []string        `json:"bapp,omitempty"`
+	Source  *Source         `json:"source,omitempty"`
+	Regs    *Regs           `json:"regs,omitempty"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// Source of the request (upstream decisioning)
+type Source struct {
+	FD     int             `json:"fd,omitempty"`
+	TID    string          `json:"tid,omitempty"`
+	PChain string          `json:"pchain,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Regs legal or industry regulations
+type Regs struct {
+	COPPA int             `json:"coppa,omitempty"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
+
+// Imp single ad placement
+type Imp struct {
+	ID                string          `json:"id"`
+	Metric            []Metric        `json:"metric,omitempty"`
+	Banner            *Banner         `json:"banner,omitempty"`
+	Video             *Video          `json:"video,omitempty"`
+	Audio             *Audio          `json:"audio,omitempty"`
+	Native            *Native         `json:"native,omitempty"`
+	PMP               *PMP            `json:"pmp,omitempty"`
+	DisplayManager    string          `json:"displaymanager,omitempty"`
+	DisplayManagerVer string          `json:"displaymanagerver,omitempty"`
+	Instl             int             `json:"instl,omitempty"`
+	TagID             string          `json:"tagid,omitempty"`
+	BidFloor          float64         `json:"bidfloor,omitempty"`
+	BidFloorCur       string          `json:"bidfloorcur,omitempty"`
+	ClickBrowser      int             `json:"clickbrowser,omitempty"`
+	Secure            int             `json:"secure,omitempty"`
+	IframeBuster      []string        `json:"iframebuster,omitempty"`
+	Exp               int             `json:"exp,omitempty"`
+	Ext               json.RawMessage `json:"ext,omitempty"`
+}
+
+// Metric about the impression
+type Metric struct {
+	Type   string          `json:"type"`
+	Value  float64         `json:"value"`
+	Vendor string          `json:"vendor,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Banner display impression
+type Banner struct {
+	Format   []Format        `json:"format,omitempty"`
+	W        int             `json:"w,omitempty"`
+	H        int             `json:"h,omitempty"`
+	BType    []int           `json:"btype,omitempty"`
+	BAttr    []int           `json:"battr,omitempty"`
+	Pos      int             `json:"pos,omitempty"`
+	MIMEs    []string        `json:"mimes,omitempty"`
+	TopFrame int             `json:"topframe,omitempty"`
+	ExpDir   []int           `json:"expdir,omitempty"`
+	API      []int           `json:"api,omitempty"`
+	ID       string          `json:"id,omitempty"`
+	VCM      int             `json:"vcm,omitempty"`
+	Ext      json.RawMessage `json:"ext,omitempty"`
+}
+
+// Format allowed banner size
+type Format struct {
+	W      int             `json:"w,omitempty"`
+	H      int             `json:"h,omitempty"`
+	WRatio int             `json:"wratio,omitempty"`
+	HRatio int             `json:"hratio,omitempty"`
+	WMin   int             `json:"wmin,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Video impression
+type Video struct {
+	MIMEs          []string        `json:"mimes"`
+	MinDuration    int             `json:"minduration,omitempty"`
+	MaxDuration    int             `json:"maxduration,omitempty"`
+	Protocols      []int           `json:"protocols,omitempty"`
+	W              int             `json:"w,omitempty"`
+	H              int             `json:"h,omitempty"`
+	StartDelay     int             `json:"startdelay,omitempty"`
+	Placement      int             `json:"placement,omitempty"`
+	Linearity      int             `json:"linearity,omitempty"`
+	Skip           int             `json:"skip,omitempty"`
+	SkipMin        int             `json:"skipmin,omitempty"`
+	SkipAfter      int             `json:"skipafter,omitempty"`
+	Sequence       int             `json:"sequence,omitempty"`
+	BAttr          []int           `json:"battr,omitempty"`
+	MaxExtended    int             `json:"maxextended,omitempty"`
+	MinBitrate     int             `json:"minbitrate,omitempty"`
+	MaxBitrate     int             `json:"maxbitrate,omitempty"`
+	BoxingAllowed  int             `json:"boxingallowed,omitempty"`
+	PlaybackMethod []int           `json:"playbackmethod,omitempty"`
+	PlaybackEnd    int             `json:"playbackend,omitempty"`
+	Delivery       []int           `json:"delivery,omitempty"`
+	Pos            int             `json:"pos,omitempty"`
+	CompanionAd    []Banner        `json:"companionad,omitempty"`
+	API            []int           `json:"api,omitempty"`
+	CompanionType  []int           `json:"companiontype,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// Audio impression
+type Audio struct {
+	MIMEs         []string        `json:"mimes"`
+	MinDuration   int             `json:"minduration,omitempty"`
+	MaxDuration   int             `json:"maxduration,omitempty"`
+	Protocols     []int           `json:"protocols,omitempty"`
+	StartDelay    int             `json:"startdelay,omitempty"`
+	Sequence      int             `json:"sequence,omitempty"`
+	BAttr         []int           `json:"battr,omitempty"`
+	MaxExtended   int             `json:"maxextended,omitempty"`
+	MinBitrate    int             `json:"minbitrate,omitempty"`
+	MaxBitrate    int             `json:"maxbitrate,omitempty"`
+	Delivery      []int           `json:"delivery,omitempty"`
+	CompanionAd   []Banner        `json:"companionad,omitempty"`
+	API           []int           `json:"api,omitempty"`
+	CompanionType []int           `json:"companiontype,omitempty"`
+	MaxSeq        int             `json:"maxseq,omitempty"`
+	Feed          int             `json:"feed,omitempty"`
+	Stitched      int             `json:"stitched,omitempty"`
+	NVol          int             `json:"nvol,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// Native impression, request is the encoded native markup request
+type Native struct {
+	Request string          `json:"request"`
+	Ver     string          `json:"ver,omitempty"`
+	API     []int           `json:"api,omitempty"`
+	BAttr   []int           `json:"battr,omitempty"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// PMP private marketplace container
+type PMP struct {
+	PrivateAuction int             `json:"private_auction,omitempty"`
+	Deals          []Deal          `json:"deals,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// Deal between buyer and seller
+type Deal struct {
+	ID          string          `json:"id"`
+	BidFloor    float64         `json:"bidfloor,omitempty"`
+	BidFloorCur string          `json:"bidfloorcur,omitempty"`
+	AT          int             `json:"at,omitempty"`
+	WSeat       []string        `json:"wseat,omitempty"`
+	WADomain    []string        `json:"wadomain,omitempty"`
+	Ext         json.RawMessage `json:"ext,omitempty"`
+}
+
+// Site website inventory
+type Site struct {
+	ID            string          `json:"id,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Domain        string          `json:"domain,omitempty"`
+	Cat           []string        `json:"cat,omitempty"`
+	SectionCat    []string        `json:"sectioncat,omitempty"`
+	PageCat       []string        `json:"pagecat,omitempty"`
+	Page          string          `json:"page,omitempty"`
+	Ref           string          `json:"ref,omitempty"`
+	Search        string          `json:"search,omitempty"`
+	Mobile        int             `json:"mobile,omitempty"`
+	PrivacyPolicy int             `json:"privacypolicy,omitempty"`
+	Publisher     *Publisher      `json:"publisher,omitempty"`
+	Content       *Content        `json:"content,omitempty"`
+	Keywords      string          `json:"keywords,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// App application inventory
+type App struct {
+	ID            string          `json:"id,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Bundle        string          `json:"bundle,omitempty"`
+	Domain        string          `json:"domain,omitempty"`
+	StoreURL      string          `json:"storeurl,omitempty"`
+	Cat           []string        `json:"cat,omitempty"`
+	SectionCat    []string        `json:"sectioncat,omitempty"`
+	PageCat       []string        `json:"pagecat,omitempty"`
+	Ver           string          `json:"ver,omitempty"`
+	PrivacyPolicy int             `json:"privacypolicy,omitempty"`
+	Paid          int             `json:"paid,omitempty"`
+	Publisher     *Publisher      `json:"publisher,omitempty"`
+	Content       *Content        `json:"content,omitempty"`
+	Keywords      string          `json:"keywords,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// Publisher of the site or app
+type Publisher struct {
+	ID     string          `json:"id,omitempty"`
+	Name   string          `json:"name,omitempty"`
+	Cat    []string        `json:"cat,omitempty"`
+	Domain string          `json:"domain,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Content in which the impression will appear
+type Content struct {
+	ID                 string          `json:"id,omitempty"`
+	Episode            int             `json:"episode,omitempty"`
+	Title              string          `json:"title,omitempty"`
+	Series             string          `json:"series,omitempty"`
+	Season             string          `json:"season,omitempty"`
+	Artist             string          `json:"artist,omitempty"`
+	Genre              string          `json:"genre,omitempty"`
+	Album              string          `json:"album,omitempty"`
+	ISRC               string          `json:"isrc,omitempty"`
+	Producer           *Producer       `json:"producer,omitempty"`
+	URL                string          `json:"url,omitempty"`
+	Cat                []string        `json:"cat,omitempty"`
+	ProdQ              int             `json:"prodq,omitempty"`
+	Context            int             `json:"context,omitempty"`
+	ContentRating      string          `json:"contentrating,omitempty"`
+	UserRating         string          `json:"userrating,omitempty"`
+	QAGMediaRating     int             `json:"qagmediarating,omitempty"`
+	Keywords           string          `json:"keywords,omitempty"`
+	LiveStream         int             `json:"livestream,omitempty"`
+	SourceRelationship int             `json:"sourcerelationship,omitempty"`
+	Len                int             `json:"len,omitempty"`
+	Language           string          `json:"language,omitempty"`
+	Embeddable         int             `json:"embeddable,omitempty"`
+	Data               []Data          `json:"data,omitempty"`
+	Ext                json.RawMessage `json:"ext,omitempty"`
+}
+
+// Producer of the content
+type Producer struct {
+	ID     string          `json:"id,omitempty"`
+	Name   string          `json:"name,omitempty"`
+	Cat    []string        `json:"cat,omitempty"`
+	Domain string          `json:"domain,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Device of the user
+type Device struct {
+	UA             string          `json:"ua,omitempty"`
+	Geo            *Geo            `json:"geo,omitempty"`
+	DNT            int             `json:"dnt,omitempty"`
+	LMT            int             `json:"lmt,omitempty"`
+	IP             string          `json:"ip,omitempty"`
+	IPv6           string          `json:"ipv6,omitempty"`
+	DeviceType     int             `json:"devicetype,omitempty"`
+	Make           string          `json:"make,omitempty"`
+	Model          string          `json:"model,omitempty"`
+	OS             string          `json:"os,omitempty"`
+	OSV            string          `json:"osv,omitempty"`
+	HWV            string          `json:"hwv,omitempty"`
+	H              int             `json:"h,omitempty"`
+	W              int             `json:"w,omitempty"`
+	PPI            int             `json:"ppi,omitempty"`
+	PxRatio        float64         `json:"pxratio,omitempty"`
+	JS             int             `json:"js,omitempty"`
+	GeoFetch       int             `json:"geofetch,omitempty"`
+	FlashVer       string          `json:"flashver,omitempty"`
+	Language       string          `json:"language,omitempty"`
+	Carrier        string          `json:"carrier,omitempty"`
+	MCCMNC         string          `json:"mccmnc,omitempty"`
+	ConnectionType int             `json:"connectiontype,omitempty"`
+	IFA            string          `json:"ifa,omitempty"`
+	DIDSHA1        string          `json:"didsha1,omitempty"`
+	DIDMD5         string          `json:"didmd5,omitempty"`
+	DPIDSHA1       string          `json:"dpidsha1,omitempty"`
+	DPIDMD5        string          `json:"dpidmd5,omitempty"`
+	MACSHA1        string          `json:"macsha1,omitempty"`
+	MACMD5         string          `json:"macmd5,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// Geo location
+type Geo struct {
+	Lat           float64         `json:"lat,omitempty"`
+	Lon           float64         `json:"lon,omitempty"`
+	Type          int             `json:"type,omitempty"`
+	Accuracy      int             `json:"accuracy,omitempty"`
+	LastFix       int             `json:"lastfix,omitempty"`
+	IPService     int             `json:"ipservice,omitempty"`
+	Country       string          `json:"country,omitempty"`
+	Region        string          `json:"region,omitempty"`
+	RegionFIPS104 string          `json:"regionfips104,omitempty"`
+	Metro         string          `json:"metro,omitempty"`
+	City          string          `json:"city,omitempty"`
+	ZIP           string          `json:"zip,omitempty"`
+	UTCOffset     int             `json:"utcoffset,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// User of the device
+type User struct {
+	ID         string          `json:"id,omitempty"`
+	BuyerUID   string          `json:"buyeruid,omitempty"`
+	YOB        int             `json:"yob,omitempty"`
+	Gender     string          `json:"gender,omitempty"`
+	Keywords   string          `json:"keywords,omitempty"`
+	CustomData string          `json:"customdata,omitempty"`
+	Geo        *Geo            `json:"geo,omitempty"`
+	Data       []Data          `json:"data,omitempty"`
+	Ext        json.RawMessage `json:"ext,omitempty"`
+}
+
+// Data segments provider
+type Data struct {
+	ID      string          `json:"id,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	Segment []Segment       `json:"segment,omitempty"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// Segment of data
+type Segment struct {
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Value string          `json:"value,omitempty"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
+
+// find impression by id
+func (r *BidRequest) GetImp(id string) *Imp {
+	for i := range r.Imp {
+		if r.Imp[i].ID == id {
+			return &r.Imp[i]
+		}
+	}
+	return nil
+}
+
+// publisher id of site or app
+func (r *BidRequest) GetPublisherID() string {
+	switch {
+	case r.Site != nil && r.Site.Publisher != nil:
+		return r.Site.Publisher.ID
+	case r.App != nil && r.App.Publisher != nil:
+		return r.App.Publisher.ID
+	}
+	return ""
+}
+
+// country of device
+func (r *BidRequest) GetCountry() string {
+	if r.Device != nil && r.Device.Geo != nil {
+		return r.Device.Geo.Country
+	}
+	return ""
+}
+
+// is impression offer format
+func (imp *Imp) HasFormat(format string) bool {
+	switch format {
+	case FORMAT_BANNER:
+		return imp.Banner != nil
+	case FORMAT_VIDEO:
+		return imp.Video != nil
+	case FORMAT_AUDIO:
+		return imp.Audio != nil
+	case FORMAT_NATIVE:
+		return imp.Native != nil
+	}
+	return false
+}
+
+// find deal of impression by id
+func (imp *Imp) GetDeal(id string) *Deal {
+	if imp.PMP == nil {
+		return nil
+	}
+	for i := range imp.PMP.Deals {
+		if imp.PMP.Deals[i].ID == id {
+			return &imp.PMP.Deals[i]
+		}
+	}
+	return nil
+}
+
+// is impression open only for deals
+func (imp *Imp) IsPrivate() bool {
+	return imp.PMP != nil && imp.PMP.PrivateAuction == 1
+}
+
+// sizes offered by banner and video of impression
+func (imp *Imp) GetSizes() (res []Format) {
+
+	if imp.Banner != nil {
+		if imp.Banner.W > 0 && imp.Banner.H > 0 {
+			res = append(res, Format{W: imp.Banner.W, H: imp.Banner.H})
+		}
+		res = append(res, imp.Banner.Format...)
+	}
+
+	if imp.Video != nil && imp.Video.W > 0 && imp.Video.H > 0 {
+		res = append(res, Format{W: imp.Video.W, H: imp.Video.H})
+	}
+
+	return
+}
+
+// blocked creative attributes of every impression format
+func (imp *Imp) GetBAttr() (res []int) {
+
+	if imp.Banner != nil {
+		res = append(res, imp.Banner.BAttr...)
+	}
+	if imp.Video != nil {
+		res = append(res, imp.Video.BAttr...)
+	}
+	if imp.Audio != nil {
+		res = append(res, imp.Audio.BAttr...)
+	}
+	if imp.Native != nil {
+		res = append(res, imp.Native.BAttr...)
+	}
+
+	return
+}
+
+// currencies accepted by request
+func (r *BidRequest) GetCur() []string {
+	if len(r.Cur) == 0 {
+		return []string{DEFAULT_CURRENCY}
+	}
+	return r.Cur
+}
@@ -0,0 +1,5444 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package openrtb
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb(in *jlexer.Lexer, out *Video) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mimes":
+			if in.IsNull() {
+				in.Skip()
+				out.MIMEs = nil
+			} else {
+				in.Delim('[')
+				if out.MIMEs == nil {
+					if !in.IsDelim(']') {
+						out.MIMEs = make([]string, 0, 4)
+					} else {
+						out.MIMEs = []string{}
+					}
+				} else {
+					out.MIMEs = (out.MIMEs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.MIMEs = append(out.MIMEs, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "minduration":
+			out.MinDuration = int(in.Int())
+		case "maxduration":
+			out.MaxDuration = int(in.Int())
+		case "protocols":
+			if in.IsNull() {
+				in.Skip()
+				out.Protocols = nil
+			} else {
+				in.Delim('[')
+				if out.Protocols == nil {
+					if !in.IsDelim(']') {
+						out.Protocols = make([]int, 0, 8)
+					} else {
+						out.Protocols = []int{}
+					}
+				} else {
+					out.Protocols = (out.Protocols)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 int
+					v2 = int(in.Int())
+					out.Protocols = append(out.Protocols, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "w":
+			out.W = int(in.Int())
+		case "h":
+			out.H = int(in.Int())
+		case "startdelay":
+			out.StartDelay = int(in.Int())
+		case "placement":
+			out.Placement = int(in.Int())
+		case "linearity":
+			out.Linearity = int(in.Int())
+		case "skip":
+			out.Skip = int(in.Int())
+		case "skipmin":
+			out.SkipMin = int(in.Int())
+		case "skipafter":
+			out.SkipAfter = int(in.Int())
+		case "sequence":
+			out.Sequence = int(in.Int())
+		case "battr":
+			if in.IsNull() {
+				in.Skip()
+				out.BAttr = nil
+			} else {
+				in.Delim('[')
+				if out.BAttr == nil {
+					if !in.IsDelim(']') {
+						out.BAttr = make([]int, 0, 8)
+					} else {
+						out.BAttr = []int{}
+					}
+				} else {
+					out.BAttr = (out.BAttr)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 int
+					v3 = int(in.Int())
+					out.BAttr = append(out.BAttr, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "maxextended":
+			out.MaxExtended = int(in.Int())
+		case "minbitrate":
+			out.MinBitrate = int(in.Int())
+		case "maxbitrate":
+			out.MaxBitrate = int(in.Int())
+		case "boxingallowed":
+			out.BoxingAllowed = int(in.Int())
+		case "playbackmethod":
+			if in.IsNull() {
+				in.Skip()
+				out.PlaybackMethod = nil
+			} else {
+				in.Delim('[')
+				if out.PlaybackMethod == nil {
+					if !in.IsDelim(']') {
+						out.PlaybackMethod = make([]int, 0, 8)
+					} else {
+						out.PlaybackMethod = []int{}
+					}
+				} else {
+					out.PlaybackMethod = (out.PlaybackMethod)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 int
+					v4 = int(in.Int())
+					out.PlaybackMethod = append(out.PlaybackMethod, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "playbackend":
+			out.PlaybackEnd = int(in.Int())
+		case "delivery":
+			if in.IsNull() {
+				in.Skip()
+				out.Delivery = nil
+			} else {
+				in.Delim('[')
+				if out.Delivery == nil {
+					if !in.IsDelim(']') {
+						out.Delivery = make([]int, 0, 8)
+					} else {
+						out.Delivery = []int{}
+					}
+				} else {
+					out.Delivery = (out.Delivery)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 int
+					v5 = int(in.Int())
+					out.Delivery = append(out.Delivery, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pos":
+			out.Pos = int(in.Int())
+		case "companionad":
+			if in.IsNull() {
+				in.Skip()
+				out.CompanionAd = nil
+			} else {
+				in.Delim('[')
+				if out.CompanionAd == nil {
+					if !in.IsDelim(']') {
+						out.CompanionAd = make([]Banner, 0, 0)
+					} else {
+						out.CompanionAd = []Banner{}
+					}
+				} else {
+					out.CompanionAd = (out.CompanionAd)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 Banner
+					(v6).UnmarshalEasyJSON(in)
+					out.CompanionAd = append(out.CompanionAd, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "api":
+			if in.IsNull() {
+				in.Skip()
+				out.API = nil
+			} else {
+				in.Delim('[')
+				if out.API == nil {
+					if !in.IsDelim(']') {
+						out.API = make([]int, 0, 8)
+					} else {
+						out.API = []int{}
+					}
+				} else {
+					out.API = (out.API)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 int
+					v7 = int(in.Int())
+					out.API = append(out.API, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "companiontype":
+			if in.IsNull() {
+				in.Skip()
+				out.CompanionType = nil
+			} else {
+				in.Delim('[')
+				if out.CompanionType == nil {
+					if !in.IsDelim(']') {
+						out.CompanionType = make([]int, 0, 8)
+					} else {
+						out.CompanionType = []int{}
+					}
+				} else {
+					out.CompanionType = (out.CompanionType)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 int
+					v8 = int(in.Int())
+					out.CompanionType = append(out.CompanionType, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb(out *jwriter.Writer, in Video) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mimes\":"
+		out.RawString(prefix[1:])
+		if in.MIMEs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.MIMEs {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v10))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MinDuration != 0 {
+		const prefix string = ",\"minduration\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinDuration))
+	}
+	if in.MaxDuration != 0 {
+		const prefix string = ",\"maxduration\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxDuration))
+	}
+	if len(in.Protocols) != 0 {
+		const prefix string = ",\"protocols\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v11, v12 := range in.Protocols {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v12))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.W != 0 {
+		const prefix string = ",\"w\":"
+		out.RawString(prefix)
+		out.Int(int(in.W))
+	}
+	if in.H != 0 {
+		const prefix string = ",\"h\":"
+		out.RawString(prefix)
+		out.Int(int(in.H))
+	}
+	if in.StartDelay != 0 {
+		const prefix string = ",\"startdelay\":"
+		out.RawString(prefix)
+		out.Int(int(in.StartDelay))
+	}
+	if in.Placement != 0 {
+		const prefix string = ",\"placement\":"
+		out.RawString(prefix)
+		out.Int(int(in.Placement))
+	}
+	if in.Linearity != 0 {
+		const prefix string = ",\"linearity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Linearity))
+	}
+	if in.Skip != 0 {
+		const prefix string = ",\"skip\":"
+		out.RawString(prefix)
+		out.Int(int(in.Skip))
+	}
+	if in.SkipMin != 0 {
+		const prefix string = ",\"skipmin\":"
+		out.RawString(prefix)
+		out.Int(int(in.SkipMin))
+	}
+	if in.SkipAfter != 0 {
+		const prefix string = ",\"skipafter\":"
+		out.RawString(prefix)
+		out.Int(int(in.SkipAfter))
+	}
+	if in.Sequence != 0 {
+		const prefix string = ",\"sequence\":"
+		out.RawString(prefix)
+		out.Int(int(in.Sequence))
+	}
+	if len(in.BAttr) != 0 {
+		const prefix string = ",\"battr\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v13, v14 := range in.BAttr {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v14))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MaxExtended != 0 {
+		const prefix string = ",\"maxextended\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxExtended))
+	}
+	if in.MinBitrate != 0 {
+		const prefix string = ",\"minbitrate\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinBitrate))
+	}
+	if in.MaxBitrate != 0 {
+		const prefix string = ",\"maxbitrate\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxBitrate))
+	}
+	if in.BoxingAllowed != 0 {
+		const prefix string = ",\"boxingallowed\":"
+		out.RawString(prefix)
+		out.Int(int(in.BoxingAllowed))
+	}
+	if len(in.PlaybackMethod) != 0 {
+		const prefix string = ",\"playbackmethod\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v15, v16 := range in.PlaybackMethod {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v16))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.PlaybackEnd != 0 {
+		const prefix string = ",\"playbackend\":"
+		out.RawString(prefix)
+		out.Int(int(in.PlaybackEnd))
+	}
+	if len(in.Delivery) != 0 {
+		const prefix string = ",\"delivery\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v17, v18 := range in.Delivery {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Pos != 0 {
+		const prefix string = ",\"pos\":"
+		out.RawString(prefix)
+		out.Int(int(in.Pos))
+	}
+	if len(in.CompanionAd) != 0 {
+		const prefix string = ",\"companionad\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v19, v20 := range in.CompanionAd {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				(v20).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.API) != 0 {
+		const prefix string = ",\"api\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v21, v22 := range in.API {
+				if v21 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v22))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CompanionType) != 0 {
+		const prefix string = ",\"companiontype\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v23, v24 := range in.CompanionType {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Video) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Video) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Video) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Video) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb1(in *jlexer.Lexer, out *User) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "buyeruid":
+			out.BuyerUID = string(in.String())
+		case "yob":
+			out.YOB = int(in.Int())
+		case "gender":
+			out.Gender = string(in.String())
+		case "keywords":
+			out.Keywords = string(in.String())
+		case "customdata":
+			out.CustomData = string(in.String())
+		case "geo":
+			if in.IsNull() {
+				in.Skip()
+				out.Geo = nil
+			} else {
+				if out.Geo == nil {
+					out.Geo = new(Geo)
+				}
+				(*out.Geo).UnmarshalEasyJSON(in)
+			}
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				in.Delim('[')
+				if out.Data == nil {
+					if !in.IsDelim(']') {
+						out.Data = make([]Data, 0, 0)
+					} else {
+						out.Data = []Data{}
+					}
+				} else {
+					out.Data = (out.Data)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 Data
+					(v25).UnmarshalEasyJSON(in)
+					out.Data = append(out.Data, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb1(out *jwriter.Writer, in User) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.BuyerUID != "" {
+		const prefix string = ",\"buyeruid\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.BuyerUID))
+	}
+	if in.YOB != 0 {
+		const prefix string = ",\"yob\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.YOB))
+	}
+	if in.Gender != "" {
+		const prefix string = ",\"gender\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Gender))
+	}
+	if in.Keywords != "" {
+		const prefix string = ",\"keywords\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Keywords))
+	}
+	if in.CustomData != "" {
+		const prefix string = ",\"customdata\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.CustomData))
+	}
+	if in.Geo != nil {
+		const prefix string = ",\"geo\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Geo).MarshalEasyJSON(out)
+	}
+	if len(in.Data) != 0 {
+		const prefix string = ",\"data\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v26, v27 := range in.Data {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v User) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *User) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb1(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb2(in *jlexer.Lexer, out *Source) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "fd":
+			out.FD = int(in.Int())
+		case "tid":
+			out.TID = string(in.String())
+		case "pchain":
+			out.PChain = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb2(out *jwriter.Writer, in Source) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.FD != 0 {
+		const prefix string = ",\"fd\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.FD))
+	}
+	if in.TID != "" {
+		const prefix string = ",\"tid\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.TID))
+	}
+	if in.PChain != "" {
+		const prefix string = ",\"pchain\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.PChain))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Source) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Source) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Source) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Source) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb2(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb3(in *jlexer.Lexer, out *Site) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "domain":
+			out.Domain = string(in.String())
+		case "cat":
+			if in.IsNull() {
+				in.Skip()
+				out.Cat = nil
+			} else {
+				in.Delim('[')
+				if out.Cat == nil {
+					if !in.IsDelim(']') {
+						out.Cat = make([]string, 0, 4)
+					} else {
+						out.Cat = []string{}
+					}
+				} else {
+					out.Cat = (out.Cat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 string
+					v28 = string(in.String())
+					out.Cat = append(out.Cat, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sectioncat":
+			if in.IsNull() {
+				in.Skip()
+				out.SectionCat = nil
+			} else {
+				in.Delim('[')
+				if out.SectionCat == nil {
+					if !in.IsDelim(']') {
+						out.SectionCat = make([]string, 0, 4)
+					} else {
+						out.SectionCat = []string{}
+					}
+				} else {
+					out.SectionCat = (out.SectionCat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 string
+					v29 = string(in.String())
+					out.SectionCat = append(out.SectionCat, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pagecat":
+			if in.IsNull() {
+				in.Skip()
+				out.PageCat = nil
+			} else {
+				in.Delim('[')
+				if out.PageCat == nil {
+					if !in.IsDelim(']') {
+						out.PageCat = make([]string, 0, 4)
+					} else {
+						out.PageCat = []string{}
+					}
+				} else {
+					out.PageCat = (out.PageCat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 string
+					v30 = string(in.String())
+					out.PageCat = append(out.PageCat, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "page":
+			out.Page = string(in.String())
+		case "ref":
+			out.Ref = string(in.String())
+		case "search":
+			out.Search = string(in.String())
+		case "mobile":
+			out.Mobile = int(in.Int())
+		case "privacypolicy":
+			out.PrivacyPolicy = int(in.Int())
+		case "publisher":
+			if in.IsNull() {
+				in.Skip()
+				out.Publisher = nil
+			} else {
+				if out.Publisher == nil {
+					out.Publisher = new(Publisher)
+				}
+				(*out.Publisher).UnmarshalEasyJSON(in)
+			}
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+				out.Content = nil
+			} else {
+				if out.Content == nil {
+					out.Content = new(Content)
+				}
+				(*out.Content).UnmarshalEasyJSON(in)
+			}
+		case "keywords":
+			out.Keywords = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb3(out *jwriter.Writer, in Site) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Domain != "" {
+		const prefix string = ",\"domain\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Domain))
+	}
+	if len(in.Cat) != 0 {
+		const prefix string = ",\"cat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v31, v32 := range in.Cat {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v32))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.SectionCat) != 0 {
+		const prefix string = ",\"sectioncat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v33, v34 := range in.SectionCat {
+				if v33 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v34))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.PageCat) != 0 {
+		const prefix string = ",\"pagecat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v35, v36 := range in.PageCat {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v36))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Page != "" {
+		const prefix string = ",\"page\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Page))
+	}
+	if in.Ref != "" {
+		const prefix string = ",\"ref\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Ref))
+	}
+	if in.Search != "" {
+		const prefix string = ",\"search\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Search))
+	}
+	if in.Mobile != 0 {
+		const prefix string = ",\"mobile\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Mobile))
+	}
+	if in.PrivacyPolicy != 0 {
+		const prefix string = ",\"privacypolicy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.PrivacyPolicy))
+	}
+	if in.Publisher != nil {
+		const prefix string = ",\"publisher\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Publisher).MarshalEasyJSON(out)
+	}
+	if in.Content != nil {
+		const prefix string = ",\"content\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Content).MarshalEasyJSON(out)
+	}
+	if in.Keywords != "" {
+		const prefix string = ",\"keywords\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Keywords))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Site) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Site) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Site) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Site) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb3(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb4(in *jlexer.Lexer, out *Segment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "value":
+			out.Value = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb4(out *jwriter.Writer, in Segment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Value != "" {
+		const prefix string = ",\"value\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Value))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Segment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Segment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Segment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Segment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb4(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb5(in *jlexer.Lexer, out *Regs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coppa":
+			out.COPPA = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb5(out *jwriter.Writer, in Regs) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.COPPA != 0 {
+		const prefix string = ",\"coppa\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.COPPA))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Regs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Regs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Regs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Regs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb5(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb6(in *jlexer.Lexer, out *Publisher) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "cat":
+			if in.IsNull() {
+				in.Skip()
+				out.Cat = nil
+			} else {
+				in.Delim('[')
+				if out.Cat == nil {
+					if !in.IsDelim(']') {
+						out.Cat = make([]string, 0, 4)
+					} else {
+						out.Cat = []string{}
+					}
+				} else {
+					out.Cat = (out.Cat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 string
+					v37 = string(in.String())
+					out.Cat = append(out.Cat, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "domain":
+			out.Domain = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb6(out *jwriter.Writer, in Publisher) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if len(in.Cat) != 0 {
+		const prefix string = ",\"cat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v38, v39 := range in.Cat {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v39))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Domain != "" {
+		const prefix string = ",\"domain\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Domain))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Publisher) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Publisher) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Publisher) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Publisher) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb6(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb7(in *jlexer.Lexer, out *Producer) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "cat":
+			if in.IsNull() {
+				in.Skip()
+				out.Cat = nil
+			} else {
+				in.Delim('[')
+				if out.Cat == nil {
+					if !in.IsDelim(']') {
+						out.Cat = make([]string, 0, 4)
+					} else {
+						out.Cat = []string{}
+					}
+				} else {
+					out.Cat = (out.Cat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 string
+					v40 = string(in.String())
+					out.Cat = append(out.Cat, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "domain":
+			out.Domain = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb7(out *jwriter.Writer, in Producer) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if len(in.Cat) != 0 {
+		const prefix string = ",\"cat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v41, v42 := range in.Cat {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v42))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Domain != "" {
+		const prefix string = ",\"domain\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Domain))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Producer) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Producer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Producer) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Producer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb7(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb8(in *jlexer.Lexer, out *PMP) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "private_auction":
+			out.PrivateAuction = int(in.Int())
+		case "deals":
+			if in.IsNull() {
+				in.Skip()
+				out.Deals = nil
+			} else {
+				in.Delim('[')
+				if out.Deals == nil {
+					if !in.IsDelim(']') {
+						out.Deals = make([]Deal, 0, 0)
+					} else {
+						out.Deals = []Deal{}
+					}
+				} else {
+					out.Deals = (out.Deals)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 Deal
+					(v43).UnmarshalEasyJSON(in)
+					out.Deals = append(out.Deals, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb8(out *jwriter.Writer, in PMP) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.PrivateAuction != 0 {
+		const prefix string = ",\"private_auction\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.PrivateAuction))
+	}
+	if len(in.Deals) != 0 {
+		const prefix string = ",\"deals\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v44, v45 := range in.Deals {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PMP) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PMP) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PMP) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PMP) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb8(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb9(in *jlexer.Lexer, out *Native) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "request":
+			out.Request = string(in.String())
+		case "ver":
+			out.Ver = string(in.String())
+		case "api":
+			if in.IsNull() {
+				in.Skip()
+				out.API = nil
+			} else {
+				in.Delim('[')
+				if out.API == nil {
+					if !in.IsDelim(']') {
+						out.API = make([]int, 0, 8)
+					} else {
+						out.API = []int{}
+					}
+				} else {
+					out.API = (out.API)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 int
+					v46 = int(in.Int())
+					out.API = append(out.API, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "battr":
+			if in.IsNull() {
+				in.Skip()
+				out.BAttr = nil
+			} else {
+				in.Delim('[')
+				if out.BAttr == nil {
+					if !in.IsDelim(']') {
+						out.BAttr = make([]int, 0, 8)
+					} else {
+						out.BAttr = []int{}
+					}
+				} else {
+					out.BAttr = (out.BAttr)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v47 int
+					v47 = int(in.Int())
+					out.BAttr = append(out.BAttr, v47)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb9(out *jwriter.Writer, in Native) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"request\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Request))
+	}
+	if in.Ver != "" {
+		const prefix string = ",\"ver\":"
+		out.RawString(prefix)
+		out.String(string(in.Ver))
+	}
+	if len(in.API) != 0 {
+		const prefix string = ",\"api\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v48, v49 := range in.API {
+				if v48 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v49))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.BAttr) != 0 {
+		const prefix string = ",\"battr\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v50, v51 := range in.BAttr {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v51))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Native) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Native) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Native) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Native) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb9(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb10(in *jlexer.Lexer, out *Metric) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "value":
+			out.Value = float64(in.Float64())
+		case "vendor":
+			out.Vendor = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb10(out *jwriter.Writer, in Metric) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Value))
+	}
+	if in.Vendor != "" {
+		const prefix string = ",\"vendor\":"
+		out.RawString(prefix)
+		out.String(string(in.Vendor))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Metric) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Metric) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Metric) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Metric) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb10(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb11(in *jlexer.Lexer, out *Imp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "metric":
+			if in.IsNull() {
+				in.Skip()
+				out.Metric = nil
+			} else {
+				in.Delim('[')
+				if out.Metric == nil {
+					if !in.IsDelim(']') {
+						out.Metric = make([]Metric, 0, 1)
+					} else {
+						out.Metric = []Metric{}
+					}
+				} else {
+					out.Metric = (out.Metric)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 Metric
+					(v52).UnmarshalEasyJSON(in)
+					out.Metric = append(out.Metric, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "banner":
+			if in.IsNull() {
+				in.Skip()
+				out.Banner = nil
+			} else {
+				if out.Banner == nil {
+					out.Banner = new(Banner)
+				}
+				(*out.Banner).UnmarshalEasyJSON(in)
+			}
+		case "video":
+			if in.IsNull() {
+				in.Skip()
+				out.Video = nil
+			} else {
+				if out.Video == nil {
+					out.Video = new(Video)
+				}
+				(*out.Video).UnmarshalEasyJSON(in)
+			}
+		case "audio":
+			if in.IsNull() {
+				in.Skip()
+				out.Audio = nil
+			} else {
+				if out.Audio == nil {
+					out.Audio = new(Audio)
+				}
+				(*out.Audio).UnmarshalEasyJSON(in)
+			}
+		case "native":
+			if in.IsNull() {
+				in.Skip()
+				out.Native = nil
+			} else {
+				if out.Native == nil {
+					out.Native = new(Native)
+				}
+				(*out.Native).UnmarshalEasyJSON(in)
+			}
+		case "pmp":
+			if in.IsNull() {
+				in.Skip()
+				out.PMP = nil
+			} else {
+				if out.PMP == nil {
+					out.PMP = new(PMP)
+				}
+				(*out.PMP).UnmarshalEasyJSON(in)
+			}
+		case "displaymanager":
+			out.DisplayManager = string(in.String())
+		case "displaymanagerver":
+			out.DisplayManagerVer = string(in.String())
+		case "instl":
+			out.Instl = int(in.Int())
+		case "tagid":
+			out.TagID = string(in.String())
+		case "bidfloor":
+			out.BidFloor = float64(in.Float64())
+		case "bidfloorcur":
+			out.BidFloorCur = string(in.String())
+		case "clickbrowser":
+			out.ClickBrowser = int(in.Int())
+		case "secure":
+			out.Secure = int(in.Int())
+		case "iframebuster":
+			if in.IsNull() {
+				in.Skip()
+				out.IframeBuster = nil
+			} else {
+				in.Delim('[')
+				if out.IframeBuster == nil {
+					if !in.IsDelim(']') {
+						out.IframeBuster = make([]string, 0, 4)
+					} else {
+						out.IframeBuster = []string{}
+					}
+				} else {
+					out.IframeBuster = (out.IframeBuster)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v53 string
+					v53 = string(in.String())
+					out.IframeBuster = append(out.IframeBuster, v53)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "exp":
+			out.Exp = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb11(out *jwriter.Writer, in Imp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if len(in.Metric) != 0 {
+		const prefix string = ",\"metric\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v54, v55 := range in.Metric {
+				if v54 > 0 {
+					out.RawByte(',')
+				}
+				(v55).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Banner != nil {
+		const prefix string = ",\"banner\":"
+		out.RawString(prefix)
+		(*in.Banner).MarshalEasyJSON(out)
+	}
+	if in.Video != nil {
+		const prefix string = ",\"video\":"
+		out.RawString(prefix)
+		(*in.Video).MarshalEasyJSON(out)
+	}
+	if in.Audio != nil {
+		const prefix string = ",\"audio\":"
+		out.RawString(prefix)
+		(*in.Audio).MarshalEasyJSON(out)
+	}
+	if in.Native != nil {
+		const prefix string = ",\"native\":"
+		out.RawString(prefix)
+		(*in.Native).MarshalEasyJSON(out)
+	}
+	if in.PMP != nil {
+		const prefix string = ",\"pmp\":"
+		out.RawString(prefix)
+		(*in.PMP).MarshalEasyJSON(out)
+	}
+	if in.DisplayManager != "" {
+		const prefix string = ",\"displaymanager\":"
+		out.RawString(prefix)
+		out.String(string(in.DisplayManager))
+	}
+	if in.DisplayManagerVer != "" {
+		const prefix string = ",\"displaymanagerver\":"
+		out.RawString(prefix)
+		out.String(string(in.DisplayManagerVer))
+	}
+	if in.Instl != 0 {
+		const prefix string = ",\"instl\":"
+		out.RawString(prefix)
+		out.Int(int(in.Instl))
+	}
+	if in.TagID != "" {
+		const prefix string = ",\"tagid\":"
+		out.RawString(prefix)
+		out.String(string(in.TagID))
+	}
+	if in.BidFloor != 0 {
+		const prefix string = ",\"bidfloor\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BidFloor))
+	}
+	if in.BidFloorCur != "" {
+		const prefix string = ",\"bidfloorcur\":"
+		out.RawString(prefix)
+		out.String(string(in.BidFloorCur))
+	}
+	if in.ClickBrowser != 0 {
+		const prefix string = ",\"clickbrowser\":"
+		out.RawString(prefix)
+		out.Int(int(in.ClickBrowser))
+	}
+	if in.Secure != 0 {
+		const prefix string = ",\"secure\":"
+		out.RawString(prefix)
+		out.Int(int(in.Secure))
+	}
+	if len(in.IframeBuster) != 0 {
+		const prefix string = ",\"iframebuster\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v56, v57 := range in.IframeBuster {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v57))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Exp != 0 {
+		const prefix string = ",\"exp\":"
+		out.RawString(prefix)
+		out.Int(int(in.Exp))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Imp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Imp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Imp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Imp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb11(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb12(in *jlexer.Lexer, out *Geo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lat":
+			out.Lat = float64(in.Float64())
+		case "lon":
+			out.Lon = float64(in.Float64())
+		case "type":
+			out.Type = int(in.Int())
+		case "accuracy":
+			out.Accuracy = int(in.Int())
+		case "lastfix":
+			out.LastFix = int(in.Int())
+		case "ipservice":
+			out.IPService = int(in.Int())
+		case "country":
+			out.Country = string(in.String())
+		case "region":
+			out.Region = string(in.String())
+		case "regionfips104":
+			out.RegionFIPS104 = string(in.String())
+		case "metro":
+			out.Metro = string(in.String())
+		case "city":
+			out.City = string(in.String())
+		case "zip":
+			out.ZIP = string(in.String())
+		case "utcoffset":
+			out.UTCOffset = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb12(out *jwriter.Writer, in Geo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Lat != 0 {
+		const prefix string = ",\"lat\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Float64(float64(in.Lat))
+	}
+	if in.Lon != 0 {
+		const prefix string = ",\"lon\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.Lon))
+	}
+	if in.Type != 0 {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Type))
+	}
+	if in.Accuracy != 0 {
+		const prefix string = ",\"accuracy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Accuracy))
+	}
+	if in.LastFix != 0 {
+		const prefix string = ",\"lastfix\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.LastFix))
+	}
+	if in.IPService != 0 {
+		const prefix string = ",\"ipservice\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.IPService))
+	}
+	if in.Country != "" {
+		const prefix string = ",\"country\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Country))
+	}
+	if in.Region != "" {
+		const prefix string = ",\"region\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Region))
+	}
+	if in.RegionFIPS104 != "" {
+		const prefix string = ",\"regionfips104\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.RegionFIPS104))
+	}
+	if in.Metro != "" {
+		const prefix string = ",\"metro\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Metro))
+	}
+	if in.City != "" {
+		const prefix string = ",\"city\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.City))
+	}
+	if in.ZIP != "" {
+		const prefix string = ",\"zip\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ZIP))
+	}
+	if in.UTCOffset != 0 {
+		const prefix string = ",\"utcoffset\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.UTCOffset))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Geo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Geo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Geo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Geo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb12(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb13(in *jlexer.Lexer, out *Format) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "w":
+			out.W = int(in.Int())
+		case "h":
+			out.H = int(in.Int())
+		case "wratio":
+			out.WRatio = int(in.Int())
+		case "hratio":
+			out.HRatio = int(in.Int())
+		case "wmin":
+			out.WMin = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb13(out *jwriter.Writer, in Format) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.W != 0 {
+		const prefix string = ",\"w\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int(int(in.W))
+	}
+	if in.H != 0 {
+		const prefix string = ",\"h\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.H))
+	}
+	if in.WRatio != 0 {
+		const prefix string = ",\"wratio\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.WRatio))
+	}
+	if in.HRatio != 0 {
+		const prefix string = ",\"hratio\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.HRatio))
+	}
+	if in.WMin != 0 {
+		const prefix string = ",\"wmin\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.WMin))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Format) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Format) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Format) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Format) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb13(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb14(in *jlexer.Lexer, out *Device) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ua":
+			out.UA = string(in.String())
+		case "geo":
+			if in.IsNull() {
+				in.Skip()
+				out.Geo = nil
+			} else {
+				if out.Geo == nil {
+					out.Geo = new(Geo)
+				}
+				(*out.Geo).UnmarshalEasyJSON(in)
+			}
+		case "dnt":
+			out.DNT = int(in.Int())
+		case "lmt":
+			out.LMT = int(in.Int())
+		case "ip":
+			out.IP = string(in.String())
+		case "ipv6":
+			out.IPv6 = string(in.String())
+		case "devicetype":
+			out.DeviceType = int(in.Int())
+		case "make":
+			out.Make = string(in.String())
+		case "model":
+			out.Model = string(in.String())
+		case "os":
+			out.OS = string(in.String())
+		case "osv":
+			out.OSV = string(in.String())
+		case "hwv":
+			out.HWV = string(in.String())
+		case "h":
+			out.H = int(in.Int())
+		case "w":
+			out.W = int(in.Int())
+		case "ppi":
+			out.PPI = int(in.Int())
+		case "pxratio":
+			out.PxRatio = float64(in.Float64())
+		case "js":
+			out.JS = int(in.Int())
+		case "geofetch":
+			out.GeoFetch = int(in.Int())
+		case "flashver":
+			out.FlashVer = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "carrier":
+			out.Carrier = string(in.String())
+		case "mccmnc":
+			out.MCCMNC = string(in.String())
+		case "connectiontype":
+			out.ConnectionType = int(in.Int())
+		case "ifa":
+			out.IFA = string(in.String())
+		case "didsha1":
+			out.DIDSHA1 = string(in.String())
+		case "didmd5":
+			out.DIDMD5 = string(in.String())
+		case "dpidsha1":
+			out.DPIDSHA1 = string(in.String())
+		case "dpidmd5":
+			out.DPIDMD5 = string(in.String())
+		case "macsha1":
+			out.MACSHA1 = string(in.String())
+		case "macmd5":
+			out.MACMD5 = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb14(out *jwriter.Writer, in Device) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.UA != "" {
+		const prefix string = ",\"ua\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.UA))
+	}
+	if in.Geo != nil {
+		const prefix string = ",\"geo\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Geo).MarshalEasyJSON(out)
+	}
+	if in.DNT != 0 {
+		const prefix string = ",\"dnt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.DNT))
+	}
+	if in.LMT != 0 {
+		const prefix string = ",\"lmt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.LMT))
+	}
+	if in.IP != "" {
+		const prefix string = ",\"ip\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IP))
+	}
+	if in.IPv6 != "" {
+		const prefix string = ",\"ipv6\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IPv6))
+	}
+	if in.DeviceType != 0 {
+		const prefix string = ",\"devicetype\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.DeviceType))
+	}
+	if in.Make != "" {
+		const prefix string = ",\"make\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Make))
+	}
+	if in.Model != "" {
+		const prefix string = ",\"model\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Model))
+	}
+	if in.OS != "" {
+		const prefix string = ",\"os\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OS))
+	}
+	if in.OSV != "" {
+		const prefix string = ",\"osv\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.OSV))
+	}
+	if in.HWV != "" {
+		const prefix string = ",\"hwv\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.HWV))
+	}
+	if in.H != 0 {
+		const prefix string = ",\"h\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.H))
+	}
+	if in.W != 0 {
+		const prefix string = ",\"w\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.W))
+	}
+	if in.PPI != 0 {
+		const prefix string = ",\"ppi\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.PPI))
+	}
+	if in.PxRatio != 0 {
+		const prefix string = ",\"pxratio\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Float64(float64(in.PxRatio))
+	}
+	if in.JS != 0 {
+		const prefix string = ",\"js\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.JS))
+	}
+	if in.GeoFetch != 0 {
+		const prefix string = ",\"geofetch\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.GeoFetch))
+	}
+	if in.FlashVer != "" {
+		const prefix string = ",\"flashver\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.FlashVer))
+	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Language))
+	}
+	if in.Carrier != "" {
+		const prefix string = ",\"carrier\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Carrier))
+	}
+	if in.MCCMNC != "" {
+		const prefix string = ",\"mccmnc\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MCCMNC))
+	}
+	if in.ConnectionType != 0 {
+		const prefix string = ",\"connectiontype\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ConnectionType))
+	}
+	if in.IFA != "" {
+		const prefix string = ",\"ifa\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.IFA))
+	}
+	if in.DIDSHA1 != "" {
+		const prefix string = ",\"didsha1\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DIDSHA1))
+	}
+	if in.DIDMD5 != "" {
+		const prefix string = ",\"didmd5\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DIDMD5))
+	}
+	if in.DPIDSHA1 != "" {
+		const prefix string = ",\"dpidsha1\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DPIDSHA1))
+	}
+	if in.DPIDMD5 != "" {
+		const prefix string = ",\"dpidmd5\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.DPIDMD5))
+	}
+	if in.MACSHA1 != "" {
+		const prefix string = ",\"macsha1\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MACSHA1))
+	}
+	if in.MACMD5 != "" {
+		const prefix string = ",\"macmd5\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.MACMD5))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Device) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Device) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Device) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Device) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb14(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb15(in *jlexer.Lexer, out *Deal) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "bidfloor":
+			out.BidFloor = float64(in.Float64())
+		case "bidfloorcur":
+			out.BidFloorCur = string(in.String())
+		case "at":
+			out.AT = int(in.Int())
+		case "wseat":
+			if in.IsNull() {
+				in.Skip()
+				out.WSeat = nil
+			} else {
+				in.Delim('[')
+				if out.WSeat == nil {
+					if !in.IsDelim(']') {
+						out.WSeat = make([]string, 0, 4)
+					} else {
+						out.WSeat = []string{}
+					}
+				} else {
+					out.WSeat = (out.WSeat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 string
+					v58 = string(in.String())
+					out.WSeat = append(out.WSeat, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "wadomain":
+			if in.IsNull() {
+				in.Skip()
+				out.WADomain = nil
+			} else {
+				in.Delim('[')
+				if out.WADomain == nil {
+					if !in.IsDelim(']') {
+						out.WADomain = make([]string, 0, 4)
+					} else {
+						out.WADomain = []string{}
+					}
+				} else {
+					out.WADomain = (out.WADomain)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v59 string
+					v59 = string(in.String())
+					out.WADomain = append(out.WADomain, v59)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb15(out *jwriter.Writer, in Deal) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.BidFloor != 0 {
+		const prefix string = ",\"bidfloor\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BidFloor))
+	}
+	if in.BidFloorCur != "" {
+		const prefix string = ",\"bidfloorcur\":"
+		out.RawString(prefix)
+		out.String(string(in.BidFloorCur))
+	}
+	if in.AT != 0 {
+		const prefix string = ",\"at\":"
+		out.RawString(prefix)
+		out.Int(int(in.AT))
+	}
+	if len(in.WSeat) != 0 {
+		const prefix string = ",\"wseat\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v60, v61 := range in.WSeat {
+				if v60 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v61))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.WADomain) != 0 {
+		const prefix string = ",\"wadomain\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v62, v63 := range in.WADomain {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v63))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Deal) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Deal) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Deal) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Deal) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb15(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb16(in *jlexer.Lexer, out *Data) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "segment":
+			if in.IsNull() {
+				in.Skip()
+				out.Segment = nil
+			} else {
+				in.Delim('[')
+				if out.Segment == nil {
+					if !in.IsDelim(']') {
+						out.Segment = make([]Segment, 0, 0)
+					} else {
+						out.Segment = []Segment{}
+					}
+				} else {
+					out.Segment = (out.Segment)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 Segment
+					(v64).UnmarshalEasyJSON(in)
+					out.Segment = append(out.Segment, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb16(out *jwriter.Writer, in Data) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if len(in.Segment) != 0 {
+		const prefix string = ",\"segment\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v65, v66 := range in.Segment {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				(v66).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Data) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Data) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Data) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Data) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb16(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb17(in *jlexer.Lexer, out *Content) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "episode":
+			out.Episode = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "series":
+			out.Series = string(in.String())
+		case "season":
+			out.Season = string(in.String())
+		case "artist":
+			out.Artist = string(in.String())
+		case "genre":
+			out.Genre = string(in.String())
+		case "album":
+			out.Album = string(in.String())
+		case "isrc":
+			out.ISRC = string(in.String())
+		case "producer":
+			if in.IsNull() {
+				in.Skip()
+				out.Producer = nil
+			} else {
+				if out.Producer == nil {
+					out.Producer = new(Producer)
+				}
+				(*out.Producer).UnmarshalEasyJSON(in)
+			}
+		case "url":
+			out.URL = string(in.String())
+		case "cat":
+			if in.IsNull() {
+				in.Skip()
+				out.Cat = nil
+			} else {
+				in.Delim('[')
+				if out.Cat == nil {
+					if !in.IsDelim(']') {
+						out.Cat = make([]string, 0, 4)
+					} else {
+						out.Cat = []string{}
+					}
+				} else {
+					out.Cat = (out.Cat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 string
+					v67 = string(in.String())
+					out.Cat = append(out.Cat, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "prodq":
+			out.ProdQ = int(in.Int())
+		case "context":
+			out.Context = int(in.Int())
+		case "contentrating":
+			out.ContentRating = string(in.String())
+		case "userrating":
+			out.UserRating = string(in.String())
+		case "qagmediarating":
+			out.QAGMediaRating = int(in.Int())
+		case "keywords":
+			out.Keywords = string(in.String())
+		case "livestream":
+			out.LiveStream = int(in.Int())
+		case "sourcerelationship":
+			out.SourceRelationship = int(in.Int())
+		case "len":
+			out.Len = int(in.Int())
+		case "language":
+			out.Language = string(in.String())
+		case "embeddable":
+			out.Embeddable = int(in.Int())
+		case "data":
+			if in.IsNull() {
+				in.Skip()
+				out.Data = nil
+			} else {
+				in.Delim('[')
+				if out.Data == nil {
+					if !in.IsDelim(']') {
+						out.Data = make([]Data, 0, 0)
+					} else {
+						out.Data = []Data{}
+					}
+				} else {
+					out.Data = (out.Data)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v68 Data
+					(v68).UnmarshalEasyJSON(in)
+					out.Data = append(out.Data, v68)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb17(out *jwriter.Writer, in Content) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Episode != 0 {
+		const prefix string = ",\"episode\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Episode))
+	}
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Title))
+	}
+	if in.Series != "" {
+		const prefix string = ",\"series\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Series))
+	}
+	if in.Season != "" {
+		const prefix string = ",\"season\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Season))
+	}
+	if in.Artist != "" {
+		const prefix string = ",\"artist\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Artist))
+	}
+	if in.Genre != "" {
+		const prefix string = ",\"genre\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Genre))
+	}
+	if in.Album != "" {
+		const prefix string = ",\"album\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Album))
+	}
+	if in.ISRC != "" {
+		const prefix string = ",\"isrc\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ISRC))
+	}
+	if in.Producer != nil {
+		const prefix string = ",\"producer\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Producer).MarshalEasyJSON(out)
+	}
+	if in.URL != "" {
+		const prefix string = ",\"url\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.URL))
+	}
+	if len(in.Cat) != 0 {
+		const prefix string = ",\"cat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v69, v70 := range in.Cat {
+				if v69 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v70))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ProdQ != 0 {
+		const prefix string = ",\"prodq\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.ProdQ))
+	}
+	if in.Context != 0 {
+		const prefix string = ",\"context\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Context))
+	}
+	if in.ContentRating != "" {
+		const prefix string = ",\"contentrating\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ContentRating))
+	}
+	if in.UserRating != "" {
+		const prefix string = ",\"userrating\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.UserRating))
+	}
+	if in.QAGMediaRating != 0 {
+		const prefix string = ",\"qagmediarating\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.QAGMediaRating))
+	}
+	if in.Keywords != "" {
+		const prefix string = ",\"keywords\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Keywords))
+	}
+	if in.LiveStream != 0 {
+		const prefix string = ",\"livestream\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.LiveStream))
+	}
+	if in.SourceRelationship != 0 {
+		const prefix string = ",\"sourcerelationship\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.SourceRelationship))
+	}
+	if in.Len != 0 {
+		const prefix string = ",\"len\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Len))
+	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Language))
+	}
+	if in.Embeddable != 0 {
+		const prefix string = ",\"embeddable\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Embeddable))
+	}
+	if len(in.Data) != 0 {
+		const prefix string = ",\"data\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v71, v72 := range in.Data {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				(v72).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Content) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Content) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Content) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Content) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb17(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb18(in *jlexer.Lexer, out *BidRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "imp":
+			if in.IsNull() {
+				in.Skip()
+				out.Imp = nil
+			} else {
+				in.Delim('[')
+				if out.Imp == nil {
+					if !in.IsDelim(']') {
+						out.Imp = make([]Imp, 0, 0)
+					} else {
+						out.Imp = []Imp{}
+					}
+				} else {
+					out.Imp = (out.Imp)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 Imp
+					(v73).UnmarshalEasyJSON(in)
+					out.Imp = append(out.Imp, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "site":
+			if in.IsNull() {
+				in.Skip()
+				out.Site = nil
+			} else {
+				if out.Site == nil {
+					out.Site = new(Site)
+				}
+				(*out.Site).UnmarshalEasyJSON(in)
+			}
+		case "app":
+			if in.IsNull() {
+				in.Skip()
+				out.App = nil
+			} else {
+				if out.App == nil {
+					out.App = new(App)
+				}
+				(*out.App).UnmarshalEasyJSON(in)
+			}
+		case "device":
+			if in.IsNull() {
+				in.Skip()
+				out.Device = nil
+			} else {
+				if out.Device == nil {
+					out.Device = new(Device)
+				}
+				(*out.Device).UnmarshalEasyJSON(in)
+			}
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(User)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "test":
+			out.Test = int(in.Int())
+		case "at":
+			out.AT = int(in.Int())
+		case "tmax":
+			out.TMax = int(in.Int())
+		case "wseat":
+			if in.IsNull() {
+				in.Skip()
+				out.WSeat = nil
+			} else {
+				in.Delim('[')
+				if out.WSeat == nil {
+					if !in.IsDelim(']') {
+						out.WSeat = make([]string, 0, 4)
+					} else {
+						out.WSeat = []string{}
+					}
+				} else {
+					out.WSeat = (out.WSeat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v74 string
+					v74 = string(in.String())
+					out.WSeat = append(out.WSeat, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "bseat":
+			if in.IsNull() {
+				in.Skip()
+				out.BSeat = nil
+			} else {
+				in.Delim('[')
+				if out.BSeat == nil {
+					if !in.IsDelim(']') {
+						out.BSeat = make([]string, 0, 4)
+					} else {
+						out.BSeat = []string{}
+					}
+				} else {
+					out.BSeat = (out.BSeat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 string
+					v75 = string(in.String())
+					out.BSeat = append(out.BSeat, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "allimps":
+			out.AllImps = int(in.Int())
+		case "cur":
+			if in.IsNull() {
+				in.Skip()
+				out.Cur = nil
+			} else {
+				in.Delim('[')
+				if out.Cur == nil {
+					if !in.IsDelim(']') {
+						out.Cur = make([]string, 0, 4)
+					} else {
+						out.Cur = []string{}
+					}
+				} else {
+					out.Cur = (out.Cur)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 string
+					v76 = string(in.String())
+					out.Cur = append(out.Cur, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "wlang":
+			if in.IsNull() {
+				in.Skip()
+				out.WLang = nil
+			} else {
+				in.Delim('[')
+				if out.WLang == nil {
+					if !in.IsDelim(']') {
+						out.WLang = make([]string, 0, 4)
+					} else {
+						out.WLang = []string{}
+					}
+				} else {
+					out.WLang = (out.WLang)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v77 string
+					v77 = string(in.String())
+					out.WLang = append(out.WLang, v77)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "bcat":
+			if in.IsNull() {
+				in.Skip()
+				out.BCat = nil
+			} else {
+				in.Delim('[')
+				if out.BCat == nil {
+					if !in.IsDelim(']') {
+						out.BCat = make([]string, 0, 4)
+					} else {
+						out.BCat = []string{}
+					}
+				} else {
+					out.BCat = (out.BCat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 string
+					v78 = string(in.String())
+					out.BCat = append(out.BCat, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "badv":
+			if in.IsNull() {
+				in.Skip()
+				out.BAdv = nil
+			} else {
+				in.Delim('[')
+				if out.BAdv == nil {
+					if !in.IsDelim(']') {
+						out.BAdv = make([]string, 0, 4)
+					} else {
+						out.BAdv = []string{}
+					}
+				} else {
+					out.BAdv = (out.BAdv)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 string
+					v79 = string(in.String())
+					out.BAdv = append(out.BAdv, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "bapp":
+			if in.IsNull() {
+				in.Skip()
+				out.BApp = nil
+			} else {
+				in.Delim('[')
+				if out.BApp == nil {
+					if !in.IsDelim(']') {
+						out.BApp = make([]string, 0, 4)
+					} else {
+						out.BApp = []string{}
+					}
+				} else {
+					out.BApp = (out.BApp)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v80 string
+					v80 = string(in.String())
+					out.BApp = append(out.BApp, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "source":
+			if in.IsNull() {
+				in.Skip()
+				out.Source = nil
+			} else {
+				if out.Source == nil {
+					out.Source = new(Source)
+				}
+				(*out.Source).UnmarshalEasyJSON(in)
+			}
+		case "regs":
+			if in.IsNull() {
+				in.Skip()
+				out.Regs = nil
+			} else {
+				if out.Regs == nil {
+					out.Regs = new(Regs)
+				}
+				(*out.Regs).UnmarshalEasyJSON(in)
+			}
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb18(out *jwriter.Writer, in BidRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"imp\":"
+		out.RawString(prefix)
+		if in.Imp == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v81, v82 := range in.Imp {
+				if v81 > 0 {
+					out.RawByte(',')
+				}
+				(v82).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Site != nil {
+		const prefix string = ",\"site\":"
+		out.RawString(prefix)
+		(*in.Site).MarshalEasyJSON(out)
+	}
+	if in.App != nil {
+		const prefix string = ",\"app\":"
+		out.RawString(prefix)
+		(*in.App).MarshalEasyJSON(out)
+	}
+	if in.Device != nil {
+		const prefix string = ",\"device\":"
+		out.RawString(prefix)
+		(*in.Device).MarshalEasyJSON(out)
+	}
+	if in.User != nil {
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		(*in.User).MarshalEasyJSON(out)
+	}
+	if in.Test != 0 {
+		const prefix string = ",\"test\":"
+		out.RawString(prefix)
+		out.Int(int(in.Test))
+	}
+	if in.AT != 0 {
+		const prefix string = ",\"at\":"
+		out.RawString(prefix)
+		out.Int(int(in.AT))
+	}
+	if in.TMax != 0 {
+		const prefix string = ",\"tmax\":"
+		out.RawString(prefix)
+		out.Int(int(in.TMax))
+	}
+	if len(in.WSeat) != 0 {
+		const prefix string = ",\"wseat\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v83, v84 := range in.WSeat {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v84))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.BSeat) != 0 {
+		const prefix string = ",\"bseat\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v85, v86 := range in.BSeat {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v86))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.AllImps != 0 {
+		const prefix string = ",\"allimps\":"
+		out.RawString(prefix)
+		out.Int(int(in.AllImps))
+	}
+	if len(in.Cur) != 0 {
+		const prefix string = ",\"cur\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v87, v88 := range in.Cur {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v88))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.WLang) != 0 {
+		const prefix string = ",\"wlang\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v89, v90 := range in.WLang {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v90))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.BCat) != 0 {
+		const prefix string = ",\"bcat\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v91, v92 := range in.BCat {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v92))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.BAdv) != 0 {
+		const prefix string = ",\"badv\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v93, v94 := range in.BAdv {
+				if v93 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v94))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.BApp) != 0 {
+		const prefix string = ",\"bapp\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v95, v96 := range in.BApp {
+				if v95 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v96))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Source != nil {
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		(*in.Source).MarshalEasyJSON(out)
+	}
+	if in.Regs != nil {
+		const prefix string = ",\"regs\":"
+		out.RawString(prefix)
+		(*in.Regs).MarshalEasyJSON(out)
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BidRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BidRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BidRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BidRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb18(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb19(in *jlexer.Lexer, out *Banner) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "format":
+			if in.IsNull() {
+				in.Skip()
+				out.Format = nil
+			} else {
+				in.Delim('[')
+				if out.Format == nil {
+					if !in.IsDelim(']') {
+						out.Format = make([]Format, 0, 1)
+					} else {
+						out.Format = []Format{}
+					}
+				} else {
+					out.Format = (out.Format)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v97 Format
+					(v97).UnmarshalEasyJSON(in)
+					out.Format = append(out.Format, v97)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "w":
+			out.W = int(in.Int())
+		case "h":
+			out.H = int(in.Int())
+		case "btype":
+			if in.IsNull() {
+				in.Skip()
+				out.BType = nil
+			} else {
+				in.Delim('[')
+				if out.BType == nil {
+					if !in.IsDelim(']') {
+						out.BType = make([]int, 0, 8)
+					} else {
+						out.BType = []int{}
+					}
+				} else {
+					out.BType = (out.BType)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v98 int
+					v98 = int(in.Int())
+					out.BType = append(out.BType, v98)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "battr":
+			if in.IsNull() {
+				in.Skip()
+				out.BAttr = nil
+			} else {
+				in.Delim('[')
+				if out.BAttr == nil {
+					if !in.IsDelim(']') {
+						out.BAttr = make([]int, 0, 8)
+					} else {
+						out.BAttr = []int{}
+					}
+				} else {
+					out.BAttr = (out.BAttr)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v99 int
+					v99 = int(in.Int())
+					out.BAttr = append(out.BAttr, v99)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pos":
+			out.Pos = int(in.Int())
+		case "mimes":
+			if in.IsNull() {
+				in.Skip()
+				out.MIMEs = nil
+			} else {
+				in.Delim('[')
+				if out.MIMEs == nil {
+					if !in.IsDelim(']') {
+						out.MIMEs = make([]string, 0, 4)
+					} else {
+						out.MIMEs = []string{}
+					}
+				} else {
+					out.MIMEs = (out.MIMEs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v100 string
+					v100 = string(in.String())
+					out.MIMEs = append(out.MIMEs, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "topframe":
+			out.TopFrame = int(in.Int())
+		case "expdir":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpDir = nil
+			} else {
+				in.Delim('[')
+				if out.ExpDir == nil {
+					if !in.IsDelim(']') {
+						out.ExpDir = make([]int, 0, 8)
+					} else {
+						out.ExpDir = []int{}
+					}
+				} else {
+					out.ExpDir = (out.ExpDir)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v101 int
+					v101 = int(in.Int())
+					out.ExpDir = append(out.ExpDir, v101)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "api":
+			if in.IsNull() {
+				in.Skip()
+				out.API = nil
+			} else {
+				in.Delim('[')
+				if out.API == nil {
+					if !in.IsDelim(']') {
+						out.API = make([]int, 0, 8)
+					} else {
+						out.API = []int{}
+					}
+				} else {
+					out.API = (out.API)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v102 int
+					v102 = int(in.Int())
+					out.API = append(out.API, v102)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.ID = string(in.String())
+		case "vcm":
+			out.VCM = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb19(out *jwriter.Writer, in Banner) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if len(in.Format) != 0 {
+		const prefix string = ",\"format\":"
+		first = false
+		out.RawString(prefix[1:])
+		{
+			out.RawByte('[')
+			for v103, v104 := range in.Format {
+				if v103 > 0 {
+					out.RawByte(',')
+				}
+				(v104).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.W != 0 {
+		const prefix string = ",\"w\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.W))
+	}
+	if in.H != 0 {
+		const prefix string = ",\"h\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.H))
+	}
+	if len(in.BType) != 0 {
+		const prefix string = ",\"btype\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v105, v106 := range in.BType {
+				if v105 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v106))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.BAttr) != 0 {
+		const prefix string = ",\"battr\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v107, v108 := range in.BAttr {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v108))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Pos != 0 {
+		const prefix string = ",\"pos\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Pos))
+	}
+	if len(in.MIMEs) != 0 {
+		const prefix string = ",\"mimes\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v109, v110 := range in.MIMEs {
+				if v109 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v110))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.TopFrame != 0 {
+		const prefix string = ",\"topframe\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.TopFrame))
+	}
+	if len(in.ExpDir) != 0 {
+		const prefix string = ",\"expdir\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v111, v112 := range in.ExpDir {
+				if v111 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v112))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.API) != 0 {
+		const prefix string = ",\"api\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v113, v114 := range in.API {
+				if v113 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v114))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.ID))
+	}
+	if in.VCM != 0 {
+		const prefix string = ",\"vcm\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.VCM))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Banner) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Banner) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Banner) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Banner) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb19(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb20(in *jlexer.Lexer, out *Audio) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mimes":
+			if in.IsNull() {
+				in.Skip()
+				out.MIMEs = nil
+			} else {
+				in.Delim('[')
+				if out.MIMEs == nil {
+					if !in.IsDelim(']') {
+						out.MIMEs = make([]string, 0, 4)
+					} else {
+						out.MIMEs = []string{}
+					}
+				} else {
+					out.MIMEs = (out.MIMEs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v115 string
+					v115 = string(in.String())
+					out.MIMEs = append(out.MIMEs, v115)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "minduration":
+			out.MinDuration = int(in.Int())
+		case "maxduration":
+			out.MaxDuration = int(in.Int())
+		case "protocols":
+			if in.IsNull() {
+				in.Skip()
+				out.Protocols = nil
+			} else {
+				in.Delim('[')
+				if out.Protocols == nil {
+					if !in.IsDelim(']') {
+						out.Protocols = make([]int, 0, 8)
+					} else {
+						out.Protocols = []int{}
+					}
+				} else {
+					out.Protocols = (out.Protocols)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v116 int
+					v116 = int(in.Int())
+					out.Protocols = append(out.Protocols, v116)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "startdelay":
+			out.StartDelay = int(in.Int())
+		case "sequence":
+			out.Sequence = int(in.Int())
+		case "battr":
+			if in.IsNull() {
+				in.Skip()
+				out.BAttr = nil
+			} else {
+				in.Delim('[')
+				if out.BAttr == nil {
+					if !in.IsDelim(']') {
+						out.BAttr = make([]int, 0, 8)
+					} else {
+						out.BAttr = []int{}
+					}
+				} else {
+					out.BAttr = (out.BAttr)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v117 int
+					v117 = int(in.Int())
+					out.BAttr = append(out.BAttr, v117)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "maxextended":
+			out.MaxExtended = int(in.Int())
+		case "minbitrate":
+			out.MinBitrate = int(in.Int())
+		case "maxbitrate":
+			out.MaxBitrate = int(in.Int())
+		case "delivery":
+			if in.IsNull() {
+				in.Skip()
+				out.Delivery = nil
+			} else {
+				in.Delim('[')
+				if out.Delivery == nil {
+					if !in.IsDelim(']') {
+						out.Delivery = make([]int, 0, 8)
+					} else {
+						out.Delivery = []int{}
+					}
+				} else {
+					out.Delivery = (out.Delivery)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v118 int
+					v118 = int(in.Int())
+					out.Delivery = append(out.Delivery, v118)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "companionad":
+			if in.IsNull() {
+				in.Skip()
+				out.CompanionAd = nil
+			} else {
+				in.Delim('[')
+				if out.CompanionAd == nil {
+					if !in.IsDelim(']') {
+						out.CompanionAd = make([]Banner, 0, 0)
+					} else {
+						out.CompanionAd = []Banner{}
+					}
+				} else {
+					out.CompanionAd = (out.CompanionAd)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v119 Banner
+					(v119).UnmarshalEasyJSON(in)
+					out.CompanionAd = append(out.CompanionAd, v119)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "api":
+			if in.IsNull() {
+				in.Skip()
+				out.API = nil
+			} else {
+				in.Delim('[')
+				if out.API == nil {
+					if !in.IsDelim(']') {
+						out.API = make([]int, 0, 8)
+					} else {
+						out.API = []int{}
+					}
+				} else {
+					out.API = (out.API)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v120 int
+					v120 = int(in.Int())
+					out.API = append(out.API, v120)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "companiontype":
+			if in.IsNull() {
+				in.Skip()
+				out.CompanionType = nil
+			} else {
+				in.Delim('[')
+				if out.CompanionType == nil {
+					if !in.IsDelim(']') {
+						out.CompanionType = make([]int, 0, 8)
+					} else {
+						out.CompanionType = []int{}
+					}
+				} else {
+					out.CompanionType = (out.CompanionType)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v121 int
+					v121 = int(in.Int())
+					out.CompanionType = append(out.CompanionType, v121)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "maxseq":
+			out.MaxSeq = int(in.Int())
+		case "feed":
+			out.Feed = int(in.Int())
+		case "stitched":
+			out.Stitched = int(in.Int())
+		case "nvol":
+			out.NVol = int(in.Int())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb20(out *jwriter.Writer, in Audio) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mimes\":"
+		out.RawString(prefix[1:])
+		if in.MIMEs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v122, v123 := range in.MIMEs {
+				if v122 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v123))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MinDuration != 0 {
+		const prefix string = ",\"minduration\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinDuration))
+	}
+	if in.MaxDuration != 0 {
+		const prefix string = ",\"maxduration\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxDuration))
+	}
+	if len(in.Protocols) != 0 {
+		const prefix string = ",\"protocols\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v124, v125 := range in.Protocols {
+				if v124 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v125))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.StartDelay != 0 {
+		const prefix string = ",\"startdelay\":"
+		out.RawString(prefix)
+		out.Int(int(in.StartDelay))
+	}
+	if in.Sequence != 0 {
+		const prefix string = ",\"sequence\":"
+		out.RawString(prefix)
+		out.Int(int(in.Sequence))
+	}
+	if len(in.BAttr) != 0 {
+		const prefix string = ",\"battr\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v126, v127 := range in.BAttr {
+				if v126 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v127))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MaxExtended != 0 {
+		const prefix string = ",\"maxextended\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxExtended))
+	}
+	if in.MinBitrate != 0 {
+		const prefix string = ",\"minbitrate\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinBitrate))
+	}
+	if in.MaxBitrate != 0 {
+		const prefix string = ",\"maxbitrate\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxBitrate))
+	}
+	if len(in.Delivery) != 0 {
+		const prefix string = ",\"delivery\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v128, v129 := range in.Delivery {
+				if v128 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v129))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CompanionAd) != 0 {
+		const prefix string = ",\"companionad\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v130, v131 := range in.CompanionAd {
+				if v130 > 0 {
+					out.RawByte(',')
+				}
+				(v131).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.API) != 0 {
+		const prefix string = ",\"api\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v132, v133 := range in.API {
+				if v132 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v133))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.CompanionType) != 0 {
+		const prefix string = ",\"companiontype\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v134, v135 := range in.CompanionType {
+				if v134 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v135))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.MaxSeq != 0 {
+		const prefix string = ",\"maxseq\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxSeq))
+	}
+	if in.Feed != 0 {
+		const prefix string = ",\"feed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Feed))
+	}
+	if in.Stitched != 0 {
+		const prefix string = ",\"stitched\":"
+		out.RawString(prefix)
+		out.Int(int(in.Stitched))
+	}
+	if in.NVol != 0 {
+		const prefix string = ",\"nvol\":"
+		out.RawString(prefix)
+		out.Int(int(in.NVol))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		out.RawString(prefix)
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Audio) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Audio) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Audio) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Audio) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb20(l, v)
+}
+func easyjson3c9d2b01DecodeAirpushAuctionOpenrtb21(in *jlexer.Lexer, out *App) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "bundle":
+			out.Bundle = string(in.String())
+		case "domain":
+			out.Domain = string(in.String())
+		case "storeurl":
+			out.StoreURL = string(in.String())
+		case "cat":
+			if in.IsNull() {
+				in.Skip()
+				out.Cat = nil
+			} else {
+				in.Delim('[')
+				if out.Cat == nil {
+					if !in.IsDelim(']') {
+						out.Cat = make([]string, 0, 4)
+					} else {
+						out.Cat = []string{}
+					}
+				} else {
+					out.Cat = (out.Cat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v136 string
+					v136 = string(in.String())
+					out.Cat = append(out.Cat, v136)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sectioncat":
+			if in.IsNull() {
+				in.Skip()
+				out.SectionCat = nil
+			} else {
+				in.Delim('[')
+				if out.SectionCat == nil {
+					if !in.IsDelim(']') {
+						out.SectionCat = make([]string, 0, 4)
+					} else {
+						out.SectionCat = []string{}
+					}
+				} else {
+					out.SectionCat = (out.SectionCat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v137 string
+					v137 = string(in.String())
+					out.SectionCat = append(out.SectionCat, v137)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "pagecat":
+			if in.IsNull() {
+				in.Skip()
+				out.PageCat = nil
+			} else {
+				in.Delim('[')
+				if out.PageCat == nil {
+					if !in.IsDelim(']') {
+						out.PageCat = make([]string, 0, 4)
+					} else {
+						out.PageCat = []string{}
+					}
+				} else {
+					out.PageCat = (out.PageCat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v138 string
+					v138 = string(in.String())
+					out.PageCat = append(out.PageCat, v138)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ver":
+			out.Ver = string(in.String())
+		case "privacypolicy":
+			out.PrivacyPolicy = int(in.Int())
+		case "paid":
+			out.Paid = int(in.Int())
+		case "publisher":
+			if in.IsNull() {
+				in.Skip()
+				out.Publisher = nil
+			} else {
+				if out.Publisher == nil {
+					out.Publisher = new(Publisher)
+				}
+				(*out.Publisher).UnmarshalEasyJSON(in)
+			}
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+				out.Content = nil
+			} else {
+				if out.Content == nil {
+					out.Content = new(Content)
+				}
+				(*out.Content).UnmarshalEasyJSON(in)
+			}
+		case "keywords":
+			out.Keywords = string(in.String())
+		case "ext":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Ext).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3c9d2b01EncodeAirpushAuctionOpenrtb21(out *jwriter.Writer, in App) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ID != "" {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Name))
+	}
+	if in.Bundle != "" {
+		const prefix string = ",\"bundle\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Bundle))
+	}
+	if in.Domain != "" {
+		const prefix string = ",\"domain\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Domain))
+	}
+	if in.StoreURL != "" {
+		const prefix string = ",\"storeurl\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.StoreURL))
+	}
+	if len(in.Cat) != 0 {
+		const prefix string = ",\"cat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v139, v140 := range in.Cat {
+				if v139 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v140))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.SectionCat) != 0 {
+		const prefix string = ",\"sectioncat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v141, v142 := range in.SectionCat {
+				if v141 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v142))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.PageCat) != 0 {
+		const prefix string = ",\"pagecat\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		{
+			out.RawByte('[')
+			for v143, v144 := range in.PageCat {
+				if v143 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v144))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Ver != "" {
+		const prefix string = ",\"ver\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Ver))
+	}
+	if in.PrivacyPolicy != 0 {
+		const prefix string = ",\"privacypolicy\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.PrivacyPolicy))
+	}
+	if in.Paid != 0 {
+		const prefix string = ",\"paid\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Paid))
+	}
+	if in.Publisher != nil {
+		const prefix string = ",\"publisher\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Publisher).MarshalEasyJSON(out)
+	}
+	if in.Content != nil {
+		const prefix string = ",\"content\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		(*in.Content).MarshalEasyJSON(out)
+	}
+	if in.Keywords != "" {
+		const prefix string = ",\"keywords\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Keywords))
+	}
+	if len(in.Ext) != 0 {
+		const prefix string = ",\"ext\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.Ext).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v App) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v App) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson3c9d2b01EncodeAirpushAuctionOpenrtb21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *App) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *App) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson3c9d2b01DecodeAirpushAuctionOpenrtb21(l, v)
+}
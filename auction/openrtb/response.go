@@ -0,0 +1,63 @@
+package openrtb
+
+import "encoding/json"
+
+// BidResponse top-level object
+type BidResponse struct {
+	ID         string          `json:"id"`
+	SeatBid    []SeatBid       `json:"seatbid,omitempty"`
+	BidID      string          `json:"bidid,omitempty"`
+	Cur        string          `json:"cur,omitempty"`
+	CustomData string          `json:"customdata,omitempty"`
+	NBR        int             `json:"nbr,omitempty"`
+	Ext        json.RawMessage `json:"ext,omitempty"`
+}
+
+// SeatBid collection of bids made by a seat
+type SeatBid struct {
+	Bid   []Bid           `json:"bid"`
+	Seat  string          `json:"seat,omitempty"`
+	Group int             `json:"group,omitempty"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
+
+// Bid offer to buy a specific impression
+type Bid struct {
+	ID             string          `json:"id"`
+	ImpID          string          `json:"impid"`
+	Price          float64         `json:"price"`
+	NURL           string          `json:"nurl,omitempty"`
+	BURL           string          `json:"burl,omitempty"`
+	LURL           string          `json:"lurl,omitempty"`
+	AdM            string          `json:"adm,omitempty"`
+	AdID           string          `json:"adid,omitempty"`
+	ADomain        []string        `json:"adomain,omitempty"`
+	Bundle         string          `json:"bundle,omitempty"`
+	IURL           string          `json:"iurl,omitempty"`
+	CID            string          `json:"cid,omitempty"`
+	CrID           string          `json:"crid,omitempty"`
+	Tactic         string          `json:"tactic,omitempty"`
+	Cat            []string        `json:"cat,omitempty"`
+	Attr           []int           `json:"attr,omitempty"`
+	API            int             `json:"api,omitempty"`
+	Protocol       int             `json:"protocol,omitempty"`
+	QAGMediaRating int             `json:"qagmediarating,omitempty"`
+	Language       string          `json:"language,omitempty"`
+	DealID         string          `json:"dealid,omitempty"`
+	W              int             `json:"w,omitempty"`
+	H              int             `json:"h,omitempty"`
+	WRatio         int             `json:"wratio,omitempty"`
+	HRatio         int             `json:"hratio,omitempty"`
+	Exp            int             `json:"exp,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// is response carry at least one bid
+func (r *BidResponse) HasBids() bool {
+	for _, s := range r.SeatBid {
+		if len(s.Bid) > 0 {
+			return true
+		}
+	}
+	return false
+}
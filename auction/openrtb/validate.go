@@ -0,0 +1,98 @@
+package openrtb
+
+import (
+	"airpush/auction/native"
+	"fmt"
+)
+
+// validate bid request against openrtb 2.5 required fields
+func (r *BidRequest) Validate() error {
+
+	if r.ID == "" {
+		return fmt.Errorf("request id is empty")
+	}
+
+	if len(r.Imp) == 0 {
+		return fmt.Errorf("request %s has no imp", r.ID)
+	}
+
+	if r.Site != nil && r.App != nil {
+		return fmt.Errorf("request %s has both site and app", r.ID)
+	}
+
+	if r.TMax < 0 {
+		return fmt.Errorf("request %s has negative tmax", r.ID)
+	}
+
+	if r.AT != 0 && r.AT != AUCTION_TYPE_FIRST_PRICE && r.AT != AUCTION_TYPE_SECOND_PRICE && r.AT < 500 {
+		return fmt.Errorf("request %s has unknown auction type %d", r.ID, r.AT)
+	}
+
+	ids := make(map[string]bool, len(r.Imp))
+	for i := range r.Imp {
+
+		imp := &r.Imp[i]
+		if err := imp.Validate(); err != nil {
+			return fmt.Errorf("request %s: %s", r.ID, err)
+		}
+
+		if ids[imp.ID] {
+			return fmt.Errorf("request %s has duplicate imp id %s", r.ID, imp.ID)
+		}
+		ids[imp.ID] = true
+	}
+
+	return nil
+}
+
+// validate single impression
+func (imp *Imp) Validate() error {
+
+	if imp.ID == "" {
+		return fmt.Errorf("imp id is empty")
+	}
+
+	if imp.Banner == nil && imp.Video == nil && imp.Audio == nil && imp.Native == nil {
+		return fmt.Errorf("imp %s has no banner, video, audio or native object", imp.ID)
+	}
+
+	if imp.BidFloor < 0 {
+		return fmt.Errorf("imp %s has negative bidfloor", imp.ID)
+	}
+
+	if imp.Video != nil && len(imp.Video.MIMEs) == 0 {
+		return fmt.Errorf("imp %s video has no mimes", imp.ID)
+	}
+
+	if imp.Video != nil && imp.Video.MaxDuration > 0 && imp.Video.MinDuration > imp.Video.MaxDuration {
+		return fmt.Errorf("imp %s video minduration is above maxduration", imp.ID)
+	}
+
+	if imp.Audio != nil && len(imp.Audio.MIMEs) == 0 {
+		return fmt.Errorf("imp %s audio has no mimes", imp.ID)
+	}
+
+	if imp.Native != nil && imp.Native.Request == "" {
+		return fmt.Errorf("imp %s native has empty request", imp.ID)
+	}
+
+	if imp.Native != nil {
+		nreq, err := native.ParseRequest(imp.Native.Request)
+		if err != nil {
+			return fmt.Errorf("imp %s native request: %s", imp.ID, err)
+		}
+		if err = nreq.Validate(); err != nil {
+			return fmt.Errorf("imp %s: %s", imp.ID, err)
+		}
+	}
+
+	if imp.PMP != nil {
+		for _, d := range imp.PMP.Deals {
+			if d.ID == "" {
+				return fmt.Errorf("imp %s has deal with empty id", imp.ID)
+			}
+		}
+	}
+
+	return nil
+}
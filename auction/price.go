@@ -0,0 +1,46 @@
+package auction
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/openrtb"
+)
+
+// price winner pay in exchange currency, offers sorted by priority and price, one per dsp seat
+// first price: own bid
+// second price: runner-up of same priority or floor plus increment, never above own bid
+// deal winner use deal floor and deal auction type, fixed price deal pay deal floor
+func (a *Auction) clearingPrice(imp *openrtb.Imp, offers []*bid.RtbResponse) float64 {
+
+	win := offers[0]
+	aType, floor := a.aType, imp.BidFloor
+
+	if win.IsDeal() {
+		floor = win.Deal.BidFloor
+		switch win.Deal.AT {
+		case openrtb.AUCTION_TYPE_FIRST_PRICE:
+			aType = TYPE_FIRST_PRICE
+		case openrtb.AUCTION_TYPE_SECOND_PRICE:
+			aType = TYPE_SECOND_PRICE
+		case openrtb.AUCTION_TYPE_FIXED_PRICE:
+			return floor
+		}
+	}
+
+	if aType != TYPE_SECOND_PRICE {
+		return win.Value
+	}
+
+	price := floor
+	if len(offers) > 1 && offers[1].Priority == win.Priority {
+		if second := offers[1].Value; second > price {
+			price = second
+		}
+	}
+
+	price += a.increment
+	if price > win.Value {
+		price = win.Value
+	}
+
+	return price
+}
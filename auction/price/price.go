@@ -0,0 +1,182 @@
+package price
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// parts of encrypted price, iv | price xor pad | signature
+const IV_SIZE = 16
+const PRICE_SIZE = 8
+const SIGNATURE_SIZE = 4
+const MESSAGE_SIZE = IV_SIZE + PRICE_SIZE + SIGNATURE_SIZE
+
+// price is encrypted in micros of currency unit
+const MICROS = 1000000
+
+// price errors
+var ErrInvalidPrice = fmt.Errorf("invalid encrypted price")
+var ErrSignature = fmt.Errorf("encrypted price signature mismatch")
+
+// settings setter
+type CrypterOption func(*Crypter)
+
+// key of price pad
+func SetEncryptionKey(key []byte) CrypterOption {
+	return func(c *Crypter) {
+		c.encryptionKey = key
+	}
+}
+
+// key of price signature
+func SetIntegrityKey(key []byte) CrypterOption {
+	return func(c *Crypter) {
+		c.integrityKey = key
+	}
+}
+
+// Crypter encrypt and decrypt ${AUCTION_PRICE} by doubleclick scheme
+// 28 bytes message is web safe base64 encoded without padding
+// pad = hmac-sha1(encryption key, iv)[:8], price = micros xor pad
+// signature = hmac-sha1(integrity key, micros | iv)[:4]
+type Crypter struct {
+	encryptionKey []byte
+	integrityKey []byte
+}
+
+// init crypter, both keys are required
+func New(opts ...CrypterOption) (*Crypter, error) {
+
+	proto := &Crypter{}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	if len(proto.encryptionKey) == 0 || len(proto.integrityKey) == 0 {
+		return nil, fmt.Errorf("encryption and integrity keys are required")
+	}
+
+	return proto, nil
+}
+
+// key from web safe or standard base64, padded or not, or hex
+func DecodeKey(s string) ([]byte, error) {
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("key is empty")
+	}
+
+	if len(s)%2 == 0 {
+		if key, err := hex.DecodeString(s); err == nil {
+			return key, nil
+		}
+	}
+
+	s = strings.TrimRight(s, "=")
+	for _, enc := range []*base64.Encoding{base64.RawURLEncoding, base64.RawStdEncoding} {
+		if key, err := enc.DecodeString(s); err == nil {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("key is neither base64 nor hex")
+}
+
+// encrypted cpm price with fresh iv
+func (c *Crypter) Encrypt(price float64) string {
+	return c.EncryptMicros(Micros(price), NewIV())
+}
+
+// decrypted cpm price
+func (c *Crypter) Decrypt(s string) (float64, error) {
+
+	micros, _, err := c.DecryptMicros(s)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(micros) / MICROS, nil
+}
+
+// encrypted micros with given iv, short iv is padded with zeros
+func (c *Crypter) EncryptMicros(micros int64, iv []byte) string {
+
+	msg := make([]byte, MESSAGE_SIZE)
+	copy(msg[:IV_SIZE], iv)
+
+	plain := msg[IV_SIZE : IV_SIZE+PRICE_SIZE]
+	binary.BigEndian.PutUint64(plain, uint64(micros))
+	copy(msg[IV_SIZE+PRICE_SIZE:], c.signature(plain, msg[:IV_SIZE]))
+
+	pad := c.pad(msg[:IV_SIZE])
+	for i := range plain {
+		plain[i] ^= pad[i]
+	}
+
+	return base64.RawURLEncoding.EncodeToString(msg)
+}
+
+// decrypted micros and iv, signature is verified
+func (c *Crypter) DecryptMicros(s string) (int64, []byte, error) {
+
+	msg, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(strings.TrimSpace(s), "="))
+	if err != nil || len(msg) != MESSAGE_SIZE {
+		return 0, nil, ErrInvalidPrice
+	}
+
+	iv := msg[:IV_SIZE]
+	plain := make([]byte, PRICE_SIZE)
+	pad := c.pad(iv)
+	for i := range plain {
+		plain[i] = msg[IV_SIZE+i] ^ pad[i]
+	}
+
+	if !hmac.Equal(msg[IV_SIZE+PRICE_SIZE:], c.signature(plain, iv)) {
+		return 0, nil, ErrSignature
+	}
+
+	return int64(binary.BigEndian.Uint64(plain)), iv, nil
+}
+
+// pad of price
+func (c *Crypter) pad(iv []byte) []byte {
+	mac := hmac.New(sha1.New, c.encryptionKey)
+	mac.Write(iv)
+	return mac.Sum(nil)[:PRICE_SIZE]
+}
+
+// signature of plain price and iv
+func (c *Crypter) signature(plain, iv []byte) []byte {
+	mac := hmac.New(sha1.New, c.integrityKey)
+	mac.Write(plain)
+	mac.Write(iv)
+	return mac.Sum(nil)[:SIGNATURE_SIZE]
+}
+
+// iv of current time in seconds and microseconds and random bytes
+func NewIV() []byte {
+
+	iv := make([]byte, IV_SIZE)
+	now := time.Now()
+	binary.BigEndian.PutUint32(iv[0:], uint32(now.Unix()))
+	binary.BigEndian.PutUint32(iv[4:], uint32(now.Nanosecond()/int(time.Microsecond)))
+	_, _ = rand.Read(iv[8:])
+
+	return iv
+}
+
+// micros of cpm price
+func Micros(price float64) int64 {
+	return int64(math.Round(price * MICROS))
+}
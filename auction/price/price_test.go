@@ -0,0 +1,146 @@
+package price
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+// test keys and iv of doubleclick price decryption guide
+const TEST_ENCRYPTION_KEY = "skU7Ax_NL5pPAFyKdkfZjZz2-VhIN8bjj1rVFOaJ_5o="
+const TEST_INTEGRITY_KEY = "arO23ykdNqUQ5LEoQ0FVmPkBd7xB5CO89PDZlSjpFxo="
+const TEST_IV = "abc123def456ghi7"
+
+// crypter of test keys
+func testCrypter(t *testing.T) *Crypter {
+
+	encryptionKey, err := DecodeKey(TEST_ENCRYPTION_KEY)
+	if err != nil {
+		t.Fatalf("encryption key: %s", err)
+	}
+
+	integrityKey, err := DecodeKey(TEST_INTEGRITY_KEY)
+	if err != nil {
+		t.Fatalf("integrity key: %s", err)
+	}
+
+	c, err := New(SetEncryptionKey(encryptionKey), SetIntegrityKey(integrityKey))
+	if err != nil {
+		t.Fatalf("crypter: %s", err)
+	}
+
+	return c
+}
+
+func TestDecryptMicros(t *testing.T) {
+
+	c := testCrypter(t)
+
+	cases := map[string]int64{
+		"YWJjMTIzZGVmNDU2Z2hpN7fhCuPemCce_6msaw": 100,
+		"YWJjMTIzZGVmNDU2Z2hpN7fhCuPemC32prpWWw": 2700,
+	}
+
+	for s, micros := range cases {
+
+		got, iv, err := c.DecryptMicros(s)
+		if err != nil {
+			t.Fatalf("decrypt %s: %s", s, err)
+		}
+		if got != micros || string(iv) != TEST_IV {
+			t.Fatalf("decrypt %s = %d iv %q, want %d iv %q", s, got, iv, micros, TEST_IV)
+		}
+
+		// same iv give same message
+		if enc := c.EncryptMicros(micros, []byte(TEST_IV)); enc != s {
+			t.Fatalf("encrypt %d = %s, want %s", micros, enc, s)
+		}
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+
+	c := testCrypter(t)
+
+	for _, price := range []float64{0, 0.0001, 1.5, 2.7, 1234.567891} {
+
+		s := c.Encrypt(price)
+		if len(s) != base64.RawURLEncoding.EncodedLen(MESSAGE_SIZE) {
+			t.Fatalf("encrypted %v length %d", price, len(s))
+		}
+
+		got, err := c.Decrypt(s)
+		if err != nil {
+			t.Fatalf("decrypt %v: %s", price, err)
+		}
+		if got != float64(Micros(price))/MICROS {
+			t.Fatalf("decrypt %v = %v", price, got)
+		}
+	}
+}
+
+func TestDecryptInvalid(t *testing.T) {
+
+	crypter := testCrypter(t)
+
+	msg, _ := base64.RawURLEncoding.DecodeString("YWJjMTIzZGVmNDU2Z2hpN7fhCuPemCce_6msaw")
+
+	signature := append([]byte{}, msg...)
+	signature[MESSAGE_SIZE-1] ^= 1
+
+	price := append([]byte{}, msg...)
+	price[IV_SIZE] ^= 1
+
+	cases := []struct {
+		name string
+		s string
+		err error
+	}{
+		{"tampered signature", base64.RawURLEncoding.EncodeToString(signature), ErrSignature},
+		{"tampered price", base64.RawURLEncoding.EncodeToString(price), ErrSignature},
+		{"short", base64.RawURLEncoding.EncodeToString(msg[:MESSAGE_SIZE-1]), ErrInvalidPrice},
+		{"long", base64.RawURLEncoding.EncodeToString(append(msg, 0)), ErrInvalidPrice},
+		{"not base64", "YWJjMTIzZGVmNDU2Z2hpN7fhCuPemCce!6msaw", ErrInvalidPrice},
+		{"empty", "", ErrInvalidPrice},
+	}
+
+	for _, c := range cases {
+		if _, err := crypter.Decrypt(c.s); err != c.err {
+			t.Fatalf("%s: error %v, want %v", c.name, err, c.err)
+		}
+	}
+
+	// other keys
+	other, _ := New(SetEncryptionKey([]byte("encryption")), SetIntegrityKey([]byte("integrity")))
+	if _, err := other.Decrypt("YWJjMTIzZGVmNDU2Z2hpN7fhCuPemCce_6msaw"); err != ErrSignature {
+		t.Fatalf("other keys error %v, want %v", err, ErrSignature)
+	}
+}
+
+func TestDecodeKey(t *testing.T) {
+
+	want, _ := base64.URLEncoding.DecodeString(TEST_ENCRYPTION_KEY)
+
+	cases := []string{
+		TEST_ENCRYPTION_KEY,
+		"skU7Ax_NL5pPAFyKdkfZjZz2-VhIN8bjj1rVFOaJ_5o",
+		base64.StdEncoding.EncodeToString(want),
+		"b2453b031fcd2f9a4f005c8a7647d98d9cf6f9584837c6e38f5ad514e689ff9a",
+	}
+
+	for _, s := range cases {
+		key, err := DecodeKey(s)
+		if err != nil || string(key) != string(want) {
+			t.Fatalf("decode %q = %x, %v", s, key, err)
+		}
+	}
+
+	for _, s := range []string{"", "  ", "not a key!"} {
+		if _, err := DecodeKey(s); err == nil {
+			t.Fatalf("decode %q accepted", s)
+		}
+	}
+
+	if _, err := New(SetEncryptionKey(want)); err == nil {
+		t.Fatalf("crypter without integrity key accepted")
+	}
+}
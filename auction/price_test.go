@@ -0,0 +1,117 @@
+package auction
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/openrtb"
+	"testing"
+)
+
+// offer of dsp seat
+func offer(dsp, seat string, value float64, priority int, deal *openrtb.Deal) *bid.RtbResponse {
+	return &bid.RtbResponse{Dsp: dsp, Seat: seat, Value: value, Priority: priority, Deal: deal}
+}
+
+func TestClearingPrice(t *testing.T) {
+
+	fixed := &openrtb.Deal{ID: "fixed", BidFloor: 3, AT: openrtb.AUCTION_TYPE_FIXED_PRICE}
+	second := &openrtb.Deal{ID: "second", BidFloor: 2, AT: openrtb.AUCTION_TYPE_SECOND_PRICE}
+	first := &openrtb.Deal{ID: "first", BidFloor: 2, AT: openrtb.AUCTION_TYPE_FIRST_PRICE}
+
+	cases := []struct {
+		name string
+		aType string
+		floor float64
+		offers []*bid.RtbResponse
+		price float64
+	}{
+		{"first price pay own bid", TYPE_FIRST_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("b", "1", 4, 0, nil),
+		}, 5},
+		{"second price pay runner-up plus increment", TYPE_SECOND_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("b", "1", 4, 0, nil),
+		}, 4.01},
+		{"second price single offer pay floor plus increment", TYPE_SECOND_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil),
+		}, 1.01},
+		{"second price runner-up below floor", TYPE_SECOND_PRICE, 3, []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("b", "1", 2, 0, nil),
+		}, 3.01},
+		{"second price never above own bid", TYPE_SECOND_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("b", "1", 5, 0, nil),
+		}, 5},
+		{"runner-up of lower priority ignored", TYPE_SECOND_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 1, second), offer("b", "1", 4, 0, nil),
+		}, 2.01},
+		{"fixed price deal pay deal floor", TYPE_SECOND_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 1, fixed),
+		}, 3},
+		{"first price deal on second price auction", TYPE_SECOND_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 1, first), offer("b", "1", 4, 1, first),
+		}, 5},
+		{"second price deal on first price auction", TYPE_FIRST_PRICE, 1, []*bid.RtbResponse{
+			offer("a", "1", 5, 1, second), offer("b", "1", 4, 1, second),
+		}, 4.01},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			a := New(SetType(c.aType), SetIncrement(0.01))
+			price := a.clearingPrice(&openrtb.Imp{ID: "1", BidFloor: c.floor}, c.offers)
+			if diff := price - c.price; diff > 1e-9 || diff < -1e-9 {
+				t.Fatalf("price %v, want %v", price, c.price)
+			}
+		})
+	}
+}
+
+func TestAuctionAT(t *testing.T) {
+
+	cases := map[string]int{
+		TYPE_FIRST_PRICE: openrtb.AUCTION_TYPE_FIRST_PRICE,
+		TYPE_SECOND_PRICE: openrtb.AUCTION_TYPE_SECOND_PRICE,
+		"": openrtb.AUCTION_TYPE_FIRST_PRICE,
+	}
+
+	for aType, at := range cases {
+		if got := New(SetType(aType)).at(); got != at {
+			t.Fatalf("type %q at %d, want %d", aType, got, at)
+		}
+	}
+}
+
+func TestDecideBestPerSeat(t *testing.T) {
+
+	cases := []struct {
+		name string
+		offers []*bid.RtbResponse
+		price float64
+	}{
+		{"runner-up of winner seat ignored", []*bid.RtbResponse{
+			offer("a", "1", 4.5, 0, nil), offer("a", "1", 5, 0, nil), offer("b", "1", 2, 0, nil),
+		}, 2.01},
+		{"other seat of same dsp compete", []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("a", "2", 4, 0, nil),
+		}, 4.01},
+		{"same seat of other dsp compete", []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("b", "1", 3, 0, nil),
+		}, 3.01},
+		{"only winner seat pay floor", []*bid.RtbResponse{
+			offer("a", "1", 5, 0, nil), offer("a", "1", 4, 0, nil),
+		}, 1.01},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			a := New(SetType(TYPE_SECOND_PRICE), SetIncrement(0.01))
+			res := a.decide(&openrtb.Imp{ID: "1", BidFloor: 1}, c.offers)
+			if res.Win == nil || res.Win.Value != 5 {
+				t.Fatalf("unexpected winner %+v", res.Win)
+			}
+			if diff := res.Win.Price - c.price; diff > 1e-9 || diff < -1e-9 {
+				t.Fatalf("price %v, want %v", res.Win.Price, c.price)
+			}
+		})
+	}
+}
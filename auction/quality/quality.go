@@ -0,0 +1,294 @@
+package quality
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/currency"
+	"airpush/auction/native"
+	"airpush/auction/openrtb"
+	"airpush/auction/vast"
+	"strings"
+)
+
+// checks names
+const CHECK_ADV = "adv"
+const CHECK_CAT = "cat"
+const CHECK_ATTR = "attr"
+const CHECK_CURRENCY = "currency"
+const CHECK_MARKUP = "markup"
+const CHECK_SIZE = "size"
+const CHECK_CREATIVE = "creative"
+const CHECK_VAST = "vast"
+const CHECK_NATIVE = "native"
+
+// every check in order of run
+var CHECKS = []string{CHECK_ADV, CHECK_CAT, CHECK_ATTR, CHECK_CURRENCY, CHECK_MARKUP, CHECK_SIZE, CHECK_CREATIVE, CHECK_VAST, CHECK_NATIVE}
+
+// settings setter
+type QualityOption func(*Quality)
+
+// enabled checks, empty list enable all, names are case insensitive
+func SetChecks(checks []string) QualityOption {
+	return func(q *Quality) {
+		if len(checks) > 0 {
+			q.checks = make([]string, len(checks))
+			for i, check := range checks {
+				q.checks[i] = CheckName(check)
+			}
+		}
+	}
+}
+
+// check name as matched on run, case and surrounding spaces are ignored
+func CheckName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// is name of known check
+func IsCheck(name string) bool {
+	name = CheckName(name)
+	for _, check := range CHECKS {
+		if check == name {
+			return true
+		}
+	}
+	return false
+}
+
+// blocked creative ids
+func SetCreatives(ids []string) QualityOption {
+	return func(q *Quality) {
+		for _, id := range ids {
+			q.creatives[id] = true
+		}
+	}
+}
+
+// exchange rates, offer in any currency with rate is accepted when request has no cur
+func SetRates(rates *currency.Rates) QualityOption {
+	return func(q *Quality) {
+		q.rates = rates
+	}
+}
+
+// Quality ad quality validation of dsp offers
+type Quality struct {
+	checks []string
+	creatives map[string]bool
+	rates *currency.Rates
+}
+
+// init quality
+func New(opts ...QualityOption) (proto *Quality) {
+
+	proto = &Quality{
+		checks: CHECKS,
+		creatives: make(map[string]bool),
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// reject reason of offer on impression, empty string for valid offer
+func (q *Quality) Check(req *openrtb.BidRequest, imp *openrtb.Imp, r *bid.RtbResponse) string {
+
+	for _, check := range q.checks {
+
+		reason := ""
+		switch check {
+		case CHECK_ADV:
+			reason = checkAdv(req, r)
+		case CHECK_CAT:
+			reason = checkCat(req, r)
+		case CHECK_ATTR:
+			reason = checkAttr(imp, r)
+		case CHECK_CURRENCY:
+			reason = checkCurrency(req, r, q.rates)
+		case CHECK_MARKUP:
+			reason = checkMarkup(r)
+		case CHECK_SIZE:
+			reason = checkSize(imp, r)
+		case CHECK_VAST:
+			reason = checkVast(imp, r)
+		case CHECK_NATIVE:
+			reason = checkNative(imp, r)
+		case CHECK_CREATIVE:
+			if q.creatives[r.Bid.CrID] {
+				reason = bid.REASON_BLOCKED_CREATIVE
+			}
+		}
+
+		if reason != "" {
+			return reason
+		}
+	}
+
+	return ""
+}
+
+// advertiser domains of badv and app bundles of bapp
+func checkAdv(req *openrtb.BidRequest, r *bid.RtbResponse) string {
+
+	for _, domain := range r.Bid.ADomain {
+		for _, blocked := range req.BAdv {
+			if isDomain(domain, blocked) {
+				return bid.REASON_BLOCKED_ADV
+			}
+		}
+	}
+
+	if r.Bid.Bundle != "" {
+		for _, blocked := range req.BApp {
+			if r.Bid.Bundle == blocked {
+				return bid.REASON_BLOCKED_APP
+			}
+		}
+	}
+
+	return ""
+}
+
+// categories of bcat, blocked tier1 category block all subcategories
+func checkCat(req *openrtb.BidRequest, r *bid.RtbResponse) string {
+
+	for _, cat := range r.Bid.Cat {
+		for _, blocked := range req.BCat {
+			if cat == blocked || strings.HasPrefix(cat, blocked + "-") {
+				return bid.REASON_BLOCKED_CAT
+			}
+		}
+	}
+
+	return ""
+}
+
+// creative attributes of battr
+func checkAttr(imp *openrtb.Imp, r *bid.RtbResponse) string {
+
+	blocked := imp.GetBAttr()
+	for _, attr := range r.Bid.Attr {
+		for _, b := range blocked {
+			if attr == b {
+				return bid.REASON_BLOCKED_ATTR
+			}
+		}
+	}
+
+	return ""
+}
+
+// currency of response is one of request cur, any currency with exchange rate when cur is absent
+func checkCurrency(req *openrtb.BidRequest, r *bid.RtbResponse, rates *currency.Rates) string {
+
+	cur := r.Cur
+	if cur == "" {
+		cur = openrtb.DEFAULT_CURRENCY
+	}
+
+	// allowed currencies of publisher
+	if len(req.Cur) > 0 {
+		for _, c := range req.Cur {
+			if strings.EqualFold(c, cur) {
+				return ""
+			}
+		}
+		return bid.REASON_CURRENCY
+	}
+
+	if rates != nil {
+		if _, ok := rates.ToBase(1, cur); ok {
+			return ""
+		}
+	}
+
+	for _, c := range req.GetCur() {
+		if strings.EqualFold(c, cur) {
+			return ""
+		}
+	}
+
+	return bid.REASON_CURRENCY
+}
+
+// markup sent inline or served by nurl
+func checkMarkup(r *bid.RtbResponse) string {
+	if r.Bid.AdM == "" && r.Bid.NURL == "" {
+		return bid.REASON_MISSING_MARKUP
+	}
+	return ""
+}
+
+// creative size is one of impression sizes, unknown size pass
+func checkSize(imp *openrtb.Imp, r *bid.RtbResponse) string {
+
+	if r.Bid.W == 0 || r.Bid.H == 0 {
+		return ""
+	}
+
+	sizes := imp.GetSizes()
+	if len(sizes) == 0 {
+		return ""
+	}
+
+	for _, s := range sizes {
+		if s.W == r.Bid.W && s.H == r.Bid.H {
+			return ""
+		}
+	}
+
+	return bid.REASON_INVALID_SIZE
+}
+
+// markup of video only impression is vast or vast tag url, vast on other impressions is validated too
+func checkVast(imp *openrtb.Imp, r *bid.RtbResponse) string {
+
+	if r.Bid.AdM == "" {
+		return ""
+	}
+
+	videoOnly := imp.Video != nil && imp.Banner == nil && imp.Native == nil && imp.Audio == nil
+	if !videoOnly && !vast.IsXML(r.Bid.AdM) {
+		return ""
+	}
+
+	if vast.Validate(r.Bid.AdM) != nil {
+		return bid.REASON_INVALID_VAST
+	}
+
+	return ""
+}
+
+// markup of native only impression fill native request, native json on other impressions is validated too
+func checkNative(imp *openrtb.Imp, r *bid.RtbResponse) string {
+
+	if imp.Native == nil || r.Bid.AdM == "" {
+		return ""
+	}
+
+	nativeOnly := imp.Banner == nil && imp.Video == nil && imp.Audio == nil
+	if !nativeOnly && !native.IsJSON(r.Bid.AdM) {
+		return ""
+	}
+
+	nreq, err := native.ParseRequest(imp.Native.Request)
+	if err != nil {
+		return bid.REASON_INVALID_NATIVE
+	}
+
+	nres, err := native.ParseResponse(r.Bid.AdM)
+	if err != nil || nres.Validate(nreq) != nil {
+		return bid.REASON_INVALID_NATIVE
+	}
+
+	return ""
+}
+
+// is domain equal or subdomain of blocked one
+func isDomain(domain, blocked string) bool {
+	domain, blocked = strings.ToLower(domain), strings.ToLower(blocked)
+	return domain == blocked || strings.HasSuffix(domain, "." + blocked)
+}
@@ -0,0 +1,152 @@
+package quality
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/currency"
+	"airpush/auction/openrtb"
+	"testing"
+)
+
+func TestCheckCurrency(t *testing.T) {
+
+	rates := currency.New(currency.SetBase("USD"))
+	rates.Set(map[string]float64{"EUR": 0.9})
+
+	cases := []struct {
+		name string
+		reqCur []string
+		bidCur string
+		rates *currency.Rates
+		reason string
+	}{
+		{"default currency", nil, "", nil, ""},
+		{"requested currency", []string{"EUR"}, "eur", nil, ""},
+		{"not requested currency", []string{"USD"}, "EUR", nil, bid.REASON_CURRENCY},
+		{"not requested currency with rate", []string{"USD"}, "EUR", rates, bid.REASON_CURRENCY},
+		{"requested currency with rate", []string{"USD", "EUR"}, "EUR", rates, ""},
+		{"default currency not requested", []string{"EUR"}, "", rates, bid.REASON_CURRENCY},
+		{"no cur, currency with rate", nil, "EUR", rates, ""},
+		{"no cur, currency without rate", nil, "GBP", rates, bid.REASON_CURRENCY},
+		{"no cur, default currency without rates", nil, "USD", nil, ""},
+		{"no cur, other currency without rates", nil, "EUR", nil, bid.REASON_CURRENCY},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			q := New(SetChecks([]string{CHECK_CURRENCY}), SetRates(c.rates))
+			req := &openrtb.BidRequest{Cur: c.reqCur}
+			r := &bid.RtbResponse{Cur: c.bidCur}
+
+			if reason := q.Check(req, &openrtb.Imp{}, r); reason != c.reason {
+				t.Fatalf("reason %q, want %q", reason, c.reason)
+			}
+		})
+	}
+}
+
+func TestSetChecksCaseInsensitive(t *testing.T) {
+
+	q := New(SetChecks([]string{"Markup", " SIZE "}))
+	req := &openrtb.BidRequest{}
+	imp := &openrtb.Imp{Banner: &openrtb.Banner{W: 300, H: 250}}
+
+	if reason := q.Check(req, imp, &bid.RtbResponse{}); reason != bid.REASON_MISSING_MARKUP {
+		t.Fatalf("reason %q, want %q", reason, bid.REASON_MISSING_MARKUP)
+	}
+
+	r := &bid.RtbResponse{Bid: openrtb.Bid{AdM: "<div/>", W: 728, H: 90}}
+	if reason := q.Check(req, imp, r); reason != bid.REASON_INVALID_SIZE {
+		t.Fatalf("reason %q, want %q", reason, bid.REASON_INVALID_SIZE)
+	}
+}
+
+func TestIsCheck(t *testing.T) {
+
+	cases := map[string]bool{
+		"price": false,
+		" price": false,
+		"markup": true,
+		" Markup ": true,
+		"NATIVE": true,
+		"": false,
+	}
+
+	for name, known := range cases {
+		if IsCheck(name) != known {
+			t.Fatalf("check %q known %t", name, !known)
+		}
+	}
+}
+
+// native request of required title and optional 300x250 image
+const TEST_NATIVE_REQUEST = `{"ver":"1.2","assets":[{"id":1,"required":1,"title":{"len":10}},{"id":2,"img":{"type":3,"w":300,"h":250}}]}`
+
+const TEST_VAST = `<VAST version="3.0"><Ad id="1"><InLine><AdSystem>dsp</AdSystem></InLine></Ad></VAST>`
+
+func TestCheck(t *testing.T) {
+
+	req := &openrtb.BidRequest{
+		BAdv: []string{"blocked.com"},
+		BApp: []string{"com.blocked.app"},
+		BCat: []string{"IAB25", "IAB7-39"},
+	}
+
+	banner := &openrtb.Imp{ID: "1", Banner: &openrtb.Banner{W: 300, H: 250, BAttr: []int{6}}}
+	video := &openrtb.Imp{ID: "1", Video: &openrtb.Video{MIMEs: []string{"video/mp4"}, BAttr: []int{16}}}
+	native := &openrtb.Imp{ID: "1", Native: &openrtb.Native{Request: TEST_NATIVE_REQUEST}}
+
+	cases := []struct {
+		name string
+		imp *openrtb.Imp
+		bid openrtb.Bid
+		reason string
+	}{
+		{"valid banner", banner, openrtb.Bid{AdM: "<div/>", ADomain: []string{"ok.com"}, Cat: []string{"IAB1"}, W: 300, H: 250}, ""},
+		{"blocked adv domain", banner, openrtb.Bid{AdM: "<div/>", ADomain: []string{"blocked.com"}}, bid.REASON_BLOCKED_ADV},
+		{"blocked adv subdomain", banner, openrtb.Bid{AdM: "<div/>", ADomain: []string{"shop.Blocked.com"}}, bid.REASON_BLOCKED_ADV},
+		{"similar adv domain", banner, openrtb.Bid{AdM: "<div/>", ADomain: []string{"notblocked.com"}}, ""},
+		{"blocked app", banner, openrtb.Bid{AdM: "<div/>", Bundle: "com.blocked.app"}, bid.REASON_BLOCKED_APP},
+		{"blocked category", banner, openrtb.Bid{AdM: "<div/>", Cat: []string{"IAB7-39"}}, bid.REASON_BLOCKED_CAT},
+		{"blocked tier1 category", banner, openrtb.Bid{AdM: "<div/>", Cat: []string{"IAB25-3"}}, bid.REASON_BLOCKED_CAT},
+		{"other subcategory", banner, openrtb.Bid{AdM: "<div/>", Cat: []string{"IAB7-3"}}, ""},
+		{"blocked banner attr", banner, openrtb.Bid{AdM: "<div/>", Attr: []int{1, 6}}, bid.REASON_BLOCKED_ATTR},
+		{"blocked video attr", video, openrtb.Bid{AdM: TEST_VAST, Attr: []int{16}}, bid.REASON_BLOCKED_ATTR},
+		{"missing markup", banner, openrtb.Bid{}, bid.REASON_MISSING_MARKUP},
+		{"markup served by nurl", banner, openrtb.Bid{NURL: "http://dsp/win"}, ""},
+		{"invalid size", banner, openrtb.Bid{AdM: "<div/>", W: 728, H: 90}, bid.REASON_INVALID_SIZE},
+		{"blocked creative", banner, openrtb.Bid{AdM: "<div/>", CrID: "cr-blocked"}, bid.REASON_BLOCKED_CREATIVE},
+		{"valid vast", video, openrtb.Bid{AdM: TEST_VAST}, ""},
+		{"vast tag url", video, openrtb.Bid{AdM: "https://dsp/vast.xml"}, ""},
+		{"invalid vast", video, openrtb.Bid{AdM: "<div/>"}, bid.REASON_INVALID_VAST},
+		{"malformed vast", video, openrtb.Bid{AdM: `<VAST version="3.0"><Ad>`}, bid.REASON_INVALID_VAST},
+		{"vast on banner", banner, openrtb.Bid{AdM: `<VAST version="1.0"></VAST>`}, bid.REASON_INVALID_VAST},
+		{"valid native", native, openrtb.Bid{AdM: `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}}]}`}, ""},
+		{"native miss required asset", native, openrtb.Bid{AdM: `{"link":{"url":"http://ok.com"},"assets":[{"id":2,"img":{"url":"http://ok.com/i.png"}}]}`}, bid.REASON_INVALID_NATIVE},
+		{"native wrong image size", native, openrtb.Bid{AdM: `{"link":{"url":"http://ok.com"},"assets":[{"id":1,"title":{"text":"title"}},{"id":2,"img":{"url":"http://ok.com/i.png","w":320,"h":50}}]}`}, bid.REASON_INVALID_NATIVE},
+		{"native not json", native, openrtb.Bid{AdM: "<div/>"}, bid.REASON_INVALID_NATIVE},
+	}
+
+	q := New(SetCreatives([]string{"cr-blocked"}))
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			r := &bid.RtbResponse{Bid: c.bid}
+			if reason := q.Check(req, c.imp, r); reason != c.reason {
+				t.Fatalf("reason %q, want %q", reason, c.reason)
+			}
+		})
+	}
+}
+
+func TestCheckDisabled(t *testing.T) {
+
+	q := New(SetChecks([]string{CHECK_MARKUP}))
+	req := &openrtb.BidRequest{BAdv: []string{"blocked.com"}}
+	r := &bid.RtbResponse{Bid: openrtb.Bid{AdM: "<div/>", ADomain: []string{"blocked.com"}}}
+
+	if reason := q.Check(req, &openrtb.Imp{ID: "1"}, r); reason != "" {
+		t.Fatalf("disabled check rejected offer %q", reason)
+	}
+}
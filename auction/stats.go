@@ -0,0 +1,50 @@
+package auction
+
+import "sync"
+
+// Stats auction counters by key
+type Stats struct {
+	mu sync.Mutex
+	counters map[string]uint64
+	funcs map[string]func() uint64
+}
+
+// init stats
+func NewStats() *Stats {
+	return &Stats{
+		counters: make(map[string]uint64),
+		funcs: make(map[string]func() uint64),
+	}
+}
+
+// counter kept by other component, read on get
+func (s *Stats) Add(key string, fn func() uint64) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	s.funcs[key] = fn
+}
+
+// increment counter
+func (s *Stats) Inc(key string) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	s.counters[key]++
+}
+
+// copy of counters
+func (s *Stats) Get() map[string]uint64 {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	res := make(map[string]uint64, len(s.counters) + len(s.funcs))
+	for k, v := range s.counters {
+		res[k] = v
+	}
+	for k, fn := range s.funcs {
+		res[k] = fn()
+	}
+
+	return res
+}
@@ -0,0 +1,100 @@
+package throttle
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// settings setter
+type ThrottleOption func(*Throttle)
+
+// max calls per second, zero for unlimited
+func SetQPS(qps float64) ThrottleOption {
+	return func(t *Throttle) {
+		t.qps = qps
+	}
+}
+
+// calls allowed at once above qps
+func SetBurst(n int) ThrottleOption {
+	return func(t *Throttle) {
+		t.burst = float64(n)
+	}
+}
+
+// percent 0..100 of auctions dsp is invited to
+func SetSample(percent int) ThrottleOption {
+	return func(t *Throttle) {
+		t.sample = percent
+	}
+}
+
+// Throttle token bucket with traffic sampling
+type Throttle struct {
+	mu sync.Mutex
+	qps float64
+	burst float64
+	sample int
+	tokens float64
+	last time.Time
+}
+
+// init throttle
+func New(opts ...ThrottleOption) (proto *Throttle) {
+
+	proto = &Throttle{
+		sample: 100,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	// burst at least one call
+	if proto.burst < 1 {
+		proto.burst = proto.qps
+		if proto.burst < 1 {
+			proto.burst = 1
+		}
+	}
+
+	proto.tokens = proto.burst
+	proto.last = time.Now()
+
+	return
+}
+
+// is call sampled in traffic
+func (t *Throttle) Sampled() bool {
+	if t.sample >= 100 {
+		return true
+	}
+	return rand.Intn(100) < t.sample
+}
+
+// take token, false when qps exceeded
+func (t *Throttle) Take() bool {
+
+	if t.qps <= 0 {
+		return true
+	}
+
+	defer t.mu.Unlock()
+	t.mu.Lock()
+
+	now := time.Now()
+	t.tokens += now.Sub(t.last).Seconds() * t.qps
+	if t.tokens > t.burst {
+		t.tokens = t.burst
+	}
+	t.last = now
+
+	if t.tokens < 1 {
+		return false
+	}
+
+	t.tokens--
+	return true
+}
@@ -0,0 +1,65 @@
+package throttle
+
+import (
+	"testing"
+)
+
+func TestTake(t *testing.T) {
+
+	cases := []struct {
+		name string
+		opts []ThrottleOption
+		calls int
+		taken int
+	}{
+		{"unlimited", nil, 1000, 1000},
+		{"burst default to qps", []ThrottleOption{SetQPS(5)}, 10, 5},
+		{"explicit burst", []ThrottleOption{SetQPS(100), SetBurst(3)}, 10, 3},
+		{"burst at least one call", []ThrottleOption{SetQPS(0.5)}, 10, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			th := New(c.opts...)
+			taken := 0
+			for i := 0; i < c.calls; i++ {
+				if th.Take() {
+					taken++
+				}
+			}
+
+			if taken != c.taken {
+				t.Fatalf("taken %d, want %d", taken, c.taken)
+			}
+		})
+	}
+}
+
+func TestSampled(t *testing.T) {
+
+	cases := []struct {
+		sample int
+		min int
+		max int
+	}{
+		{100, 1000, 1000},
+		{0, 0, 0},
+		{50, 400, 600},
+	}
+
+	for _, c := range cases {
+
+		th := New(SetSample(c.sample))
+		sampled := 0
+		for i := 0; i < 1000; i++ {
+			if th.Sampled() {
+				sampled++
+			}
+		}
+
+		if sampled < c.min || sampled > c.max {
+			t.Fatalf("sample %d: sampled %d, want %d..%d", c.sample, sampled, c.min, c.max)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package auction
+
+import (
+	"airpush/auction/bid"
+	"airpush/auction/native"
+	"airpush/auction/openrtb"
+	"airpush/auction/tracking"
+	"airpush/auction/vast"
+	"strings"
+)
+
+// embed encrypted exchange tracking in markup of winners
+// html banner get impression pixel, vast get impression, native get impression tracker and click through exchange
+func (a *Auction) track(req *openrtb.BidRequest, res *bid.AuctionResponse) {
+
+	if a.tracker == nil {
+		return
+	}
+
+	for i := range res.Imp {
+
+		ir := &res.Imp[i]
+		if ir.Win == nil {
+			continue
+		}
+
+		w := ir.Win
+		t := &tracking.Token{
+			AuctionID: req.ID,
+			ImpID: ir.ImpID,
+			BidID: w.Bid.ID,
+			Dsp: w.Dsp,
+			Price: w.Price,
+			Cur: res.Cur,
+			Domains: w.Bid.ADomain,
+			BURL: w.Bid.BURL,
+		}
+
+		token, err := a.tracker.Seal(t)
+		if err != nil {
+			continue
+		}
+		ir.Token = token
+
+		adm := a.tracker.Substitute(w.Bid.AdM, token)
+		impURL := a.tracker.URL(tracking.KIND_IMP, token)
+		imp := req.GetImp(ir.ImpID)
+
+		switch {
+		case adm == "" || imp == nil:
+		case imp.Video != nil && vast.IsXML(adm):
+			adm = vast.Inject(adm, []string{impURL}, nil)
+		case imp.Native != nil && native.IsJSON(adm):
+			adm = a.trackNative(adm, t, impURL)
+		case imp.Banner != nil && !vast.IsXML(adm) && !native.IsJSON(adm) && !vast.IsURL(adm):
+			adm += `<img src="` + strings.Replace(impURL, "&", "&amp;", -1) + `" width="1" height="1" style="display:none" alt=""/>`
+		}
+
+		w.Bid.AdM = adm
+	}
+}
+
+// add impression tracker and lead native link through exchange click with encrypted landing
+func (a *Auction) trackNative(adm string, t *tracking.Token, impURL string) string {
+
+	env := new(native.ResponseEnvelope)
+	if err := env.UnmarshalJSON([]byte(adm)); err != nil {
+		return adm
+	}
+
+	wrapped := env.Native != nil
+	res := env.Native
+	if !wrapped {
+		res = new(native.Response)
+		if err := res.UnmarshalJSON([]byte(adm)); err != nil {
+			return adm
+		}
+	}
+
+	res.EventTrackers = append(res.EventTrackers, native.EventTracker{
+		Event: native.EVENT_IMPRESSION,
+		Method: native.METHOD_IMG,
+		URL: impURL,
+	})
+
+	if res.Link != nil && res.Link.URL != "" {
+		click := *t
+		click.Landing = res.Link.URL
+		click.BURL = ""
+		if token, err := a.tracker.Seal(&click); err == nil {
+			res.Link.URL = a.tracker.URL(tracking.KIND_CLICK, token)
+		}
+	}
+
+	var buf []byte
+	var err error
+	if wrapped {
+		buf, err = env.MarshalJSON()
+	} else {
+		buf, err = res.MarshalJSON()
+	}
+	if err != nil {
+		return adm
+	}
+
+	return string(buf)
+}
@@ -0,0 +1,16 @@
+package tracking
+
+// Token auction context encrypted into tracking urls of served creative
+// short names keep urls short
+type Token struct {
+	AuctionID string `json:"a"`
+	ImpID string `json:"i"`
+	BidID string `json:"b,omitempty"`
+	Dsp string `json:"d,omitempty"`
+	Price float64 `json:"p,omitempty"`
+	Cur string `json:"c,omitempty"`
+	Domains []string `json:"ad,omitempty"`
+	Landing string `json:"l,omitempty"`
+	BURL string `json:"u,omitempty"`
+	Expire int64 `json:"e"`
+}
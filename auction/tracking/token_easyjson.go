@@ -0,0 +1,178 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package tracking
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonF041b085DecodeAirpushAuctionTracking(in *jlexer.Lexer, out *Token) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "a":
+			out.AuctionID = string(in.String())
+		case "i":
+			out.ImpID = string(in.String())
+		case "b":
+			out.BidID = string(in.String())
+		case "d":
+			out.Dsp = string(in.String())
+		case "p":
+			out.Price = float64(in.Float64())
+		case "c":
+			out.Cur = string(in.String())
+		case "ad":
+			if in.IsNull() {
+				in.Skip()
+				out.Domains = nil
+			} else {
+				in.Delim('[')
+				if out.Domains == nil {
+					if !in.IsDelim(']') {
+						out.Domains = make([]string, 0, 4)
+					} else {
+						out.Domains = []string{}
+					}
+				} else {
+					out.Domains = (out.Domains)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Domains = append(out.Domains, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "l":
+			out.Landing = string(in.String())
+		case "u":
+			out.BURL = string(in.String())
+		case "e":
+			out.Expire = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonF041b085EncodeAirpushAuctionTracking(out *jwriter.Writer, in Token) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AuctionID))
+	}
+	{
+		const prefix string = ",\"i\":"
+		out.RawString(prefix)
+		out.String(string(in.ImpID))
+	}
+	if in.BidID != "" {
+		const prefix string = ",\"b\":"
+		out.RawString(prefix)
+		out.String(string(in.BidID))
+	}
+	if in.Dsp != "" {
+		const prefix string = ",\"d\":"
+		out.RawString(prefix)
+		out.String(string(in.Dsp))
+	}
+	if in.Price != 0 {
+		const prefix string = ",\"p\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Price))
+	}
+	if in.Cur != "" {
+		const prefix string = ",\"c\":"
+		out.RawString(prefix)
+		out.String(string(in.Cur))
+	}
+	if len(in.Domains) != 0 {
+		const prefix string = ",\"ad\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Domains {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.Landing != "" {
+		const prefix string = ",\"l\":"
+		out.RawString(prefix)
+		out.String(string(in.Landing))
+	}
+	if in.BURL != "" {
+		const prefix string = ",\"u\":"
+		out.RawString(prefix)
+		out.String(string(in.BURL))
+	}
+	{
+		const prefix string = ",\"e\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Expire))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Token) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonF041b085EncodeAirpushAuctionTracking(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Token) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonF041b085EncodeAirpushAuctionTracking(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Token) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonF041b085DecodeAirpushAuctionTracking(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Token) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonF041b085DecodeAirpushAuctionTracking(l, v)
+}
@@ -0,0 +1,284 @@
+package tracking
+
+import (
+	"container/list"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tracking kinds, also route names
+const KIND_IMP = "imp"
+const KIND_CLICK = "click"
+const KIND_EVENT = "event"
+
+// macros of markup replaced with tracking urls of won impression
+// click url end with landing param, creative append escaped landing url
+const MACRO_IMP_URL = "${EXCHANGE_IMP_URL}"
+const MACRO_CLICK_URL = "${EXCHANGE_CLICK_URL}"
+
+// query params of tracking urls
+const PARAM_TOKEN = "t"
+const PARAM_URL = "url"
+const PARAM_EVENT = "e"
+
+// label of token key derived from secret
+const KEY_LABEL = "tracking token aes-256-gcm"
+
+// defaults
+const DEFAULT_TTL = time.Duration(24) * time.Hour
+const DEFAULT_DEDUPE_TTL = time.Duration(1) * time.Hour
+const DEFAULT_DEDUPE_SIZE = 1000000
+
+// token errors
+var ErrInvalidToken = fmt.Errorf("invalid token")
+var ErrExpiredToken = fmt.Errorf("expired token")
+
+// settings setter
+type TrackerOption func(*Tracker)
+
+// secret token key is derived from
+func SetSecret(secret string) TrackerOption {
+	return func(t *Tracker) {
+		t.secret = []byte(secret)
+	}
+}
+
+// public base url of exchange tracking routes
+func SetURL(base string) TrackerOption {
+	return func(t *Tracker) {
+		t.base = strings.TrimRight(base, "/")
+	}
+}
+
+// time token is accepted after auction
+func SetTTL(duration time.Duration) TrackerOption {
+	return func(t *Tracker) {
+		if duration > 0 {
+			t.ttl = duration
+		}
+	}
+}
+
+// time repeated fire is ignored
+func SetDedupeTTL(duration time.Duration) TrackerOption {
+	return func(t *Tracker) {
+		if duration > 0 {
+			t.dedupeTTL = duration
+		}
+	}
+}
+
+// max fires remembered, oldest fire is forgotten on overflow
+func SetDedupeSize(n int) TrackerOption {
+	return func(t *Tracker) {
+		if n > 0 {
+			t.dedupeSize = n
+		}
+	}
+}
+
+// source of current time, tests move it by hand
+func SetClock(now func() time.Time) TrackerOption {
+	return func(t *Tracker) {
+		if now != nil {
+			t.now = now
+		}
+	}
+}
+
+// fire remembered until expire
+type fire struct {
+	key string
+	expire time.Time
+}
+
+// Tracker encrypt tracking tokens and dedupe fires
+// token is aes-gcm sealed, price and billing url are not readable from url
+// fires expire in order they are seen, dedupe ttl is same for every fire
+type Tracker struct {
+	mu sync.Mutex
+	secret []byte
+	aead cipher.AEAD
+	base string
+	ttl time.Duration
+	dedupeTTL time.Duration
+	dedupeSize int
+	seen map[string]bool
+	fires *list.List
+	now func() time.Time
+}
+
+// init tracker
+func New(opts ...TrackerOption) (proto *Tracker) {
+
+	proto = &Tracker{
+		ttl: DEFAULT_TTL,
+		dedupeTTL: DEFAULT_DEDUPE_TTL,
+		dedupeSize: DEFAULT_DEDUPE_SIZE,
+		seen: make(map[string]bool),
+		fires: list.New(),
+		now: time.Now,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	// 32 bytes key never fail aes and gcm init
+	block, _ := aes.NewCipher(deriveKey(proto.secret))
+	proto.aead, _ = cipher.NewGCM(block)
+
+	return
+}
+
+// encrypted token, expire is set from ttl
+// random nonce | sealed json payload, web safe base64 without padding
+func (t *Tracker) Seal(token *Token) (string, error) {
+
+	token.Expire = t.now().Add(t.ttl).Unix()
+
+	payload, err := token.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, t.aead.NonceSize(), t.aead.NonceSize() + len(payload) + t.aead.Overhead())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(t.aead.Seal(nonce, nonce, payload, nil)), nil
+}
+
+// decrypt token and verify expiration
+func (t *Tracker) Parse(s string) (*Token, error) {
+
+	// strict decoding, token has single valid encoding
+	buf, err := base64.RawURLEncoding.Strict().DecodeString(s)
+	if err != nil || len(buf) < t.aead.NonceSize() {
+		return nil, ErrInvalidToken
+	}
+
+	nonce := buf[:t.aead.NonceSize()]
+	payload, err := t.aead.Open(nil, nonce, buf[len(nonce):], nil)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	token := new(Token)
+	err = token.UnmarshalJSON(payload)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if t.now().Unix() > token.Expire {
+		return nil, ErrExpiredToken
+	}
+
+	return token, nil
+}
+
+// tracking url of kind with token
+func (t *Tracker) URL(kind string, token string) string {
+	return t.base + "/" + kind + "?" + PARAM_TOKEN + "=" + token
+}
+
+// replace tracking macros of markup
+func (t *Tracker) Substitute(s string, token string) string {
+
+	// fast path, nothing to replace
+	if !strings.Contains(s, "${EXCHANGE_") {
+		return s
+	}
+
+	return strings.NewReplacer(
+		MACRO_IMP_URL, t.URL(KIND_IMP, token),
+		MACRO_CLICK_URL, t.URL(KIND_CLICK, token) + "&" + PARAM_URL + "=",
+	).Replace(s)
+}
+
+// first fire of key within dedupe ttl
+// when full oldest fire is forgotten, recent repeats are still deduped
+func (t *Tracker) First(key string) bool {
+	defer t.mu.Unlock()
+	t.mu.Lock()
+
+	now := t.now()
+	t.expire(now)
+
+	if t.seen[key] {
+		return false
+	}
+
+	if len(t.seen) >= t.dedupeSize {
+		t.forget(t.fires.Front())
+	}
+
+	t.seen[key] = true
+	t.fires.PushBack(fire{key: key, expire: now.Add(t.dedupeTTL)})
+
+	return true
+}
+
+// landing of click, url of token or param url on domain of offer
+func (t *Tracker) Landing(token *Token, param string) (string, bool) {
+
+	if token.Landing != "" {
+		return token.Landing, true
+	}
+
+	u, err := url.Parse(param)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, d := range token.Domains {
+		d = strings.ToLower(d)
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return param, true
+		}
+	}
+
+	return "", false
+}
+
+// drop expired fires from oldest, stop on first alive one
+func (t *Tracker) expire(now time.Time) {
+	for e := t.fires.Front(); e != nil; e = t.fires.Front() {
+		f := e.Value.(fire)
+		if now.Before(f.expire) {
+			return
+		}
+		t.forget(e)
+	}
+}
+
+// drop fire from order and seen keys
+func (t *Tracker) forget(e *list.Element) {
+	t.fires.Remove(e)
+	delete(t.seen, e.Value.(fire).key)
+}
+
+// aes-256 key of secret
+func deriveKey(secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(KEY_LABEL))
+	return mac.Sum(nil)
+}
+
+// dedupe key of fire
+func Key(kind, name string, token *Token) string {
+	return kind + "/" + name + "/" + token.AuctionID + "/" + token.ImpID
+}
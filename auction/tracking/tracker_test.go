@@ -0,0 +1,167 @@
+package tracking
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+const TEST_SECRET = "0123456789abcdef0123456789abcdef"
+
+func TestSealParse(t *testing.T) {
+
+	tr := New(SetSecret(TEST_SECRET))
+	token := &Token{AuctionID: "a1", ImpID: "i1", Dsp: "node_1", Price: 1.2345, BURL: "http://dsp.example.com/bill?p=1.2345"}
+
+	s, err := tr.Seal(token)
+	if err != nil {
+		t.Fatalf("seal: %s", err)
+	}
+
+	// payload is not readable from url
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatalf("token is not web safe base64: %s", err)
+	}
+	for _, plain := range []string{"1.2345", "dsp.example.com", "node_1"} {
+		if strings.Contains(string(buf), plain) {
+			t.Fatalf("token leaks %q", plain)
+		}
+	}
+
+	parsed, err := tr.Parse(s)
+	if err != nil {
+		t.Fatalf("parse: %s", err)
+	}
+	if parsed.AuctionID != "a1" || parsed.ImpID != "i1" || parsed.Price != 1.2345 || parsed.BURL != token.BURL {
+		t.Fatalf("unexpected token %+v", parsed)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+
+	tr := New(SetSecret(TEST_SECRET))
+	s, err := tr.Seal(&Token{AuctionID: "a1", ImpID: "i1"})
+	if err != nil {
+		t.Fatalf("seal: %s", err)
+	}
+
+	buf, _ := base64.RawURLEncoding.DecodeString(s)
+
+	// flip byte in middle of ciphertext, tag does not match
+	tampered := append([]byte{}, buf...)
+	tampered[tr.aead.NonceSize() + (len(buf) - tr.aead.NonceSize()) / 2] ^= 1
+
+	// same bytes with non zero padding bits of last char
+	alphabet := "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	last := strings.IndexByte(alphabet, s[len(s)-1])
+
+	cases := map[string]string{
+		"tampered": base64.RawURLEncoding.EncodeToString(tampered),
+		"truncated": s[:10],
+		"not base64": "!!!",
+		"empty": "",
+	}
+
+	if len(buf) % 3 != 0 {
+		cases["padding bits"] = s[:len(s)-1] + string(alphabet[last ^ 1])
+	}
+
+	for name, bad := range cases {
+		if _, err := tr.Parse(bad); err != ErrInvalidToken {
+			t.Fatalf("%s token error %v, want %v", name, err, ErrInvalidToken)
+		}
+	}
+
+	// token of other secret
+	other := New(SetSecret("other-secret-0123456789"))
+	if _, err := other.Parse(s); err != ErrInvalidToken {
+		t.Fatalf("other secret error %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestParseExpired(t *testing.T) {
+
+	c := &clock{now: time.Unix(1000, 0)}
+	tr := New(SetSecret(TEST_SECRET), SetTTL(time.Hour), SetClock(c.Now))
+
+	s, err := tr.Seal(&Token{AuctionID: "a1", ImpID: "i1"})
+	if err != nil {
+		t.Fatalf("seal: %s", err)
+	}
+
+	c.Add(time.Hour)
+	if _, err := tr.Parse(s); err != nil {
+		t.Fatalf("token expired before ttl: %s", err)
+	}
+
+	c.Add(time.Second)
+	if _, err := tr.Parse(s); err != ErrExpiredToken {
+		t.Fatalf("error %v, want %v", err, ErrExpiredToken)
+	}
+}
+
+// clock moved by test
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestFirst(t *testing.T) {
+
+	c := &clock{now: time.Unix(1000, 0)}
+	tr := New(SetSecret(TEST_SECRET), SetDedupeTTL(20 * time.Second), SetClock(c.Now))
+
+	if !tr.First("a") || tr.First("a") {
+		t.Fatalf("repeated fire is not deduped")
+	}
+
+	c.Add(10 * time.Second)
+	if !tr.First("b") || tr.First("a") {
+		t.Fatalf("other fire is deduped or repeated fire is not")
+	}
+
+	// first fire expired, second alive
+	c.Add(10 * time.Second)
+	if !tr.First("a") || tr.First("b") {
+		t.Fatalf("expired fire is kept or alive fire is dropped")
+	}
+
+	if len(tr.seen) != 2 || tr.fires.Len() != 2 {
+		t.Fatalf("seen %d fires %d, want 2", len(tr.seen), tr.fires.Len())
+	}
+}
+
+func TestFirstFull(t *testing.T) {
+
+	c := &clock{now: time.Unix(1000, 0)}
+	tr := New(SetSecret(TEST_SECRET), SetDedupeSize(2), SetClock(c.Now))
+
+	if !tr.First("a") || !tr.First("b") {
+		t.Fatalf("first fires are deduped")
+	}
+
+	// full, oldest fire is forgotten
+	if !tr.First("c") {
+		t.Fatalf("new fire over size is deduped")
+	}
+
+	// repeats are still rejected when full
+	for _, key := range []string{"b", "c", "c"} {
+		if tr.First(key) {
+			t.Fatalf("repeated fire %s over size is not deduped", key)
+		}
+	}
+
+	if len(tr.seen) != 2 || tr.fires.Len() != 2 || tr.seen["a"] {
+		t.Fatalf("seen %v fires %d, want b and c", tr.seen, tr.fires.Len())
+	}
+}
@@ -49,12 +49,14 @@ func New(opts ...TransactionOption) (proto *Transaction) {
 }
 
 // execute transaction
-func (tx *Transaction) Do() (err error) {
+// on timeout transaction closes, pending bids expire and received bids are kept
+// outstanding dsp calls are canceled on return
+func (tx *Transaction) Do(ctx context.Context) (err error) {
 
-	done := make(chan bool)
+	done := make(chan bool, 1)
 
 	// tx timeout
-	ctx, cancel := context.WithTimeout(context.Background(), tx.timeout)
+	ctx, cancel := context.WithTimeout(ctx, tx.timeout)
 	defer cancel()
 
 	// parent rutine for async requests
@@ -68,7 +70,7 @@ func (tx *Transaction) Do() (err error) {
 			// rutine for single async request
 			go func(b *bid.Bid) {
 				defer wg.Done()
-				b.Do()
+				b.Do(ctx)
 			}(b)
 		}
 
@@ -82,7 +84,14 @@ func (tx *Transaction) Do() (err error) {
 	case <-done:
 		return
 	case <-ctx.Done():
-		err = fmt.Errorf("tx timeout")
+		for _, b := range tx.bids {
+			b.Expire()
+		}
+
+		// parent gone, nobody wait result
+		if ctx.Err() == context.Canceled {
+			err = fmt.Errorf("tx canceled")
+		}
 		return
 	}
 }
\ No newline at end of file
@@ -0,0 +1,139 @@
+package vast
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// defaults
+const DEFAULT_TTL = time.Duration(300) * time.Second
+const DEFAULT_SIZE = 100000
+
+// Ad winning vast document with values of its macros
+type Ad struct {
+	Doc string
+	AuctionID string
+	ImpID string
+	Price float64
+	Currency string
+	expires time.Time
+}
+
+// settings setter
+type StoreOption func(*Store)
+
+// time to keep document
+func SetTTL(duration time.Duration) StoreOption {
+	return func(s *Store) {
+		if duration > 0 {
+			s.ttl = duration
+		}
+	}
+}
+
+// max documents kept, overflow is dropped
+func SetSize(n int) StoreOption {
+	return func(s *Store) {
+		if n > 0 {
+			s.size = n
+		}
+	}
+}
+
+// source of current time, tests move it by hand
+func SetClock(now func() time.Time) StoreOption {
+	return func(s *Store) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+// document in order of put
+type entry struct {
+	key string
+	ad *Ad
+}
+
+// Store winning vast documents by auction and impression
+// documents expire in order they are put, ttl is same for every document
+type Store struct {
+	mu sync.Mutex
+	ads map[string]*Ad
+	order *list.List
+	ttl time.Duration
+	size int
+	now func() time.Time
+}
+
+// init store
+func NewStore(opts ...StoreOption) (proto *Store) {
+
+	proto = &Store{
+		ads: make(map[string]*Ad),
+		order: list.New(),
+		ttl: DEFAULT_TTL,
+		size: DEFAULT_SIZE,
+		now: time.Now,
+	}
+
+	// set custom settings
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	return
+}
+
+// keep document, false when store is full
+func (s *Store) Put(ad *Ad) bool {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	now := s.now()
+	s.expire(now)
+
+	k := key(ad.AuctionID, ad.ImpID)
+	if _, ok := s.ads[k]; !ok && len(s.ads) >= s.size {
+		return false
+	}
+
+	ad.expires = now.Add(s.ttl)
+	s.ads[k] = ad
+	s.order.PushBack(entry{key: k, ad: ad})
+
+	return true
+}
+
+// get document of auction impression
+func (s *Store) Get(auctionID, impID string) (*Ad, bool) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+
+	ad, ok := s.ads[key(auctionID, impID)]
+	if !ok || s.now().After(ad.expires) {
+		return nil, false
+	}
+
+	return ad, true
+}
+
+// drop expired documents from oldest, stop on first alive one
+// replaced document is dropped from order only
+func (s *Store) expire(now time.Time) {
+	for e := s.order.Front(); e != nil; e = s.order.Front() {
+		en := e.Value.(entry)
+		if !now.After(en.ad.expires) {
+			return
+		}
+		s.order.Remove(e)
+		if s.ads[en.key] == en.ad {
+			delete(s.ads, en.key)
+		}
+	}
+}
+
+func key(auctionID, impID string) string {
+	return auctionID + "/" + impID
+}
@@ -0,0 +1,89 @@
+package vast
+
+import (
+	"testing"
+	"time"
+)
+
+// clock moved by test
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestStore(t *testing.T) {
+
+	c := &clock{now: time.Unix(1000, 0)}
+	s := NewStore(SetTTL(20 * time.Second), SetSize(2), SetClock(c.Now))
+
+	if !s.Put(&Ad{AuctionID: "a1", ImpID: "1", Doc: "first"}) || !s.Put(&Ad{AuctionID: "a2", ImpID: "1"}) {
+		t.Fatalf("put refused")
+	}
+
+	// full
+	if s.Put(&Ad{AuctionID: "a3", ImpID: "1"}) {
+		t.Fatalf("put over size accepted")
+	}
+
+	// replace is not refused when full
+	if !s.Put(&Ad{AuctionID: "a1", ImpID: "1", Doc: "second"}) {
+		t.Fatalf("replace refused")
+	}
+
+	if ad, ok := s.Get("a1", "1"); !ok || ad.Doc != "second" {
+		t.Fatalf("unexpected ad %+v", ad)
+	}
+
+	if _, ok := s.Get("a3", "1"); ok {
+		t.Fatalf("refused ad is kept")
+	}
+
+	// alive until ttl pass
+	c.Add(20 * time.Second)
+	if _, ok := s.Get("a2", "1"); !ok {
+		t.Fatalf("ad expired before ttl")
+	}
+
+	c.Add(time.Second)
+	if _, ok := s.Get("a1", "1"); ok {
+		t.Fatalf("expired ad is served")
+	}
+
+	// expired ads free space
+	if !s.Put(&Ad{AuctionID: "a3", ImpID: "1"}) || !s.Put(&Ad{AuctionID: "a4", ImpID: "1"}) {
+		t.Fatalf("put refused after expire")
+	}
+
+	if len(s.ads) != 2 || s.order.Len() != 2 {
+		t.Fatalf("ads %d order %d, want 2", len(s.ads), s.order.Len())
+	}
+}
+
+func TestStoreReplacedKeepNewer(t *testing.T) {
+
+	c := &clock{now: time.Unix(1000, 0)}
+	s := NewStore(SetTTL(20 * time.Second), SetClock(c.Now))
+	s.Put(&Ad{AuctionID: "a1", ImpID: "1", Doc: "first"})
+
+	c.Add(15 * time.Second)
+	s.Put(&Ad{AuctionID: "a1", ImpID: "1", Doc: "second"})
+
+	// first entry expired, newer document stay
+	c.Add(10 * time.Second)
+	s.Put(&Ad{AuctionID: "a2", ImpID: "1"})
+
+	if ad, ok := s.Get("a1", "1"); !ok || ad.Doc != "second" {
+		t.Fatalf("newer ad dropped with expired entry: %+v", ad)
+	}
+
+	if s.order.Len() != 2 {
+		t.Fatalf("order %d, want 2", s.order.Len())
+	}
+}
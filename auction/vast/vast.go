@@ -0,0 +1,178 @@
+package vast
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// tracking events of linear creative
+const EVENT_START = "start"
+const EVENT_FIRST_QUARTILE = "firstQuartile"
+const EVENT_MIDPOINT = "midpoint"
+const EVENT_THIRD_QUARTILE = "thirdQuartile"
+const EVENT_COMPLETE = "complete"
+
+// known events in canonical case
+var EVENTS = []string{EVENT_START, EVENT_FIRST_QUARTILE, EVENT_MIDPOINT, EVENT_THIRD_QUARTILE, EVENT_COMPLETE}
+
+// version of wrapper built from tag url
+const WRAPPER_VERSION = "3.0"
+
+// ad system of exchange in built wrapper
+const AD_SYSTEM = "airpush"
+
+var (
+	adTag = regexp.MustCompile(`<(InLine|Wrapper)(\s[^>]*)?>`)
+	linearTag = regexp.MustCompile(`(?s)<Linear(\s[^>]*)?>.*?</Linear>`)
+	trackingTag = regexp.MustCompile(`<TrackingEvents(\s[^>]*)?>`)
+)
+
+// vast document, only parts needed for validation
+type document struct {
+	XMLName xml.Name `xml:"VAST"`
+	Version string `xml:"version,attr"`
+	Ads []struct {
+		InLine *struct{} `xml:"InLine"`
+		Wrapper *struct {
+			VASTAdTagURI string `xml:"VASTAdTagURI"`
+		} `xml:"Wrapper"`
+	} `xml:"Ad"`
+}
+
+// is markup vast xml
+func IsXML(adm string) bool {
+	adm = strings.TrimSpace(adm)
+	return strings.HasPrefix(adm, "<VAST") || strings.HasPrefix(adm, "<?xml")
+}
+
+// is markup vast tag url
+func IsURL(adm string) bool {
+	adm = strings.TrimSpace(adm)
+	return strings.HasPrefix(adm, "http://") || strings.HasPrefix(adm, "https://")
+}
+
+// validate markup is well formed vast 2, 3 or 4 xml or vast tag url
+func Validate(adm string) error {
+
+	if IsURL(adm) {
+		return nil
+	}
+
+	doc := document{}
+	err := xml.Unmarshal([]byte(adm), &doc)
+	if err != nil {
+		return fmt.Errorf("vast not well formed: %s", err)
+	}
+
+	switch {
+	case strings.HasPrefix(doc.Version, "2"), strings.HasPrefix(doc.Version, "3"), strings.HasPrefix(doc.Version, "4"):
+	default:
+		return fmt.Errorf("vast version %q not supported", doc.Version)
+	}
+
+	if len(doc.Ads) == 0 {
+		return fmt.Errorf("vast has no ads")
+	}
+
+	for _, ad := range doc.Ads {
+		switch {
+		case ad.InLine != nil:
+		case ad.Wrapper != nil && strings.TrimSpace(ad.Wrapper.VASTAdTagURI) != "":
+		default:
+			return fmt.Errorf("vast ad has no inline or wrapper with tag uri")
+		}
+	}
+
+	return nil
+}
+
+// vast document of valid markup, tag url is wrapped
+func Normalize(adm string, id string) (string, error) {
+
+	err := Validate(adm)
+	if err != nil {
+		return "", err
+	}
+
+	if !IsURL(adm) {
+		return adm, nil
+	}
+
+	return fmt.Sprintf(`<VAST version="%s"><Ad id="%s"><Wrapper><AdSystem>%s</AdSystem><VASTAdTagURI>%s</VASTAdTagURI></Wrapper></Ad></VAST>`,
+		WRAPPER_VERSION, escape(id), AD_SYSTEM, cdata(strings.TrimSpace(adm))), nil
+}
+
+// add impression urls to every ad and tracking urls to every linear creative
+func Inject(doc string, impressions []string, events map[string][]string) string {
+
+	if len(impressions) > 0 {
+		var b strings.Builder
+		for _, u := range impressions {
+			b.WriteString("<Impression>" + cdata(u) + "</Impression>")
+		}
+		doc = adTag.ReplaceAllString(doc, "${0}" + escapeDollar(b.String()))
+	}
+
+	if len(events) == 0 {
+		return doc
+	}
+
+	names := make([]string, 0, len(events))
+	for event := range events {
+		names = append(names, event)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, event := range names {
+		for _, u := range events[event] {
+			b.WriteString(`<Tracking event="` + escape(event) + `">` + cdata(u) + "</Tracking>")
+		}
+	}
+	tracking := b.String()
+
+	return linearTag.ReplaceAllStringFunc(doc, func(linear string) string {
+		if loc := trackingTag.FindStringIndex(linear); loc != nil {
+			return linear[:loc[1]] + tracking + linear[loc[1]:]
+		}
+		end := strings.LastIndex(linear, "</Linear>")
+		return linear[:end] + "<TrackingEvents>" + tracking + "</TrackingEvents>" + linear[end:]
+	})
+}
+
+// restore case of known event names, config keys are lower cased
+func CanonicalEvents(events map[string][]string) map[string][]string {
+
+	res := make(map[string][]string, len(events))
+	for name, urls := range events {
+		for _, event := range EVENTS {
+			if strings.EqualFold(name, event) {
+				name = event
+				break
+			}
+		}
+		res[name] = urls
+	}
+
+	return res
+}
+
+// wrap url in cdata section
+func cdata(s string) string {
+	return "<![CDATA[" + strings.Replace(s, "]]>", "]]]]><![CDATA[>", -1) + "]]>"
+}
+
+// escape xml attribute value
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// escape $ of regexp replacement template
+func escapeDollar(s string) string {
+	return strings.Replace(s, "$", "$$", -1)
+}
@@ -0,0 +1,187 @@
+package vast
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+const TEST_INLINE = `<VAST version="3.0"><Ad id="1"><InLine><AdSystem>dsp</AdSystem><Creatives><Creative><Linear>` +
+	`<Duration>00:00:15</Duration><MediaFiles><MediaFile>http://dsp/v.mp4</MediaFile></MediaFiles></Linear></Creative></Creatives></InLine></Ad></VAST>`
+
+const TEST_WRAPPER = `<VAST version="2.0"><Ad id="1"><Wrapper><AdSystem>dsp</AdSystem><VASTAdTagURI>http://dsp/vast.xml</VASTAdTagURI>` +
+	`<Creatives><Creative><Linear><TrackingEvents><Tracking event="start">http://dsp/start</Tracking></TrackingEvents></Linear></Creative></Creatives></Wrapper></Ad></VAST>`
+
+func TestValidate(t *testing.T) {
+
+	cases := []struct {
+		name string
+		adm string
+		valid bool
+	}{
+		{"inline", TEST_INLINE, true},
+		{"wrapper", TEST_WRAPPER, true},
+		{"vast 4 with xml declaration", `<?xml version="1.0"?><VAST version="4.2"><Ad><InLine/></Ad></VAST>`, true},
+		{"tag url", " https://dsp/vast.xml ", true},
+		{"malformed xml", `<VAST version="3.0"><Ad><InLine></Ad></VAST>`, false},
+		{"not closed", `<VAST version="3.0"><Ad><InLine/></Ad>`, false},
+		{"not vast", `<div></div>`, false},
+		{"not xml", `vast`, false},
+		{"version 1", `<VAST version="1.0"><Ad><InLine/></Ad></VAST>`, false},
+		{"no version", `<VAST><Ad><InLine/></Ad></VAST>`, false},
+		{"no ads", `<VAST version="3.0"></VAST>`, false},
+		{"wrapper without tag uri", `<VAST version="3.0"><Ad><Wrapper><VASTAdTagURI> </VASTAdTagURI></Wrapper></Ad></VAST>`, false},
+		{"ad without inline or wrapper", `<VAST version="3.0"><Ad><InLine/></Ad><Ad/></VAST>`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if err := Validate(c.adm); (err == nil) != c.valid {
+				t.Fatalf("valid %t, error %v", c.valid, err)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+
+	// valid document is kept as is
+	doc, err := Normalize(TEST_INLINE, "b1")
+	if err != nil || doc != TEST_INLINE {
+		t.Fatalf("inline normalized to %q, %v", doc, err)
+	}
+
+	// tag url is wrapped with exchange version
+	doc, err = Normalize(" http://dsp/vast.xml?a=1&b=]]> ", `b"1`)
+	if err != nil {
+		t.Fatalf("normalize tag url: %s", err)
+	}
+
+	if err := Validate(doc); err != nil {
+		t.Fatalf("wrapper is not valid vast: %s", err)
+	}
+
+	wrapper := struct {
+		Version string `xml:"version,attr"`
+		Ad struct {
+			ID string `xml:"id,attr"`
+			AdSystem string `xml:"Wrapper>AdSystem"`
+			URI string `xml:"Wrapper>VASTAdTagURI"`
+		} `xml:"Ad"`
+	}{}
+	if err := xml.Unmarshal([]byte(doc), &wrapper); err != nil {
+		t.Fatalf("parse wrapper: %s", err)
+	}
+
+	if wrapper.Version != WRAPPER_VERSION || wrapper.Ad.ID != `b"1` || wrapper.Ad.AdSystem != AD_SYSTEM || wrapper.Ad.URI != "http://dsp/vast.xml?a=1&b=]]>" {
+		t.Fatalf("unexpected wrapper %+v", wrapper)
+	}
+
+	// invalid markup is not normalized
+	if _, err := Normalize(`<VAST version="3.0">`, "b1"); err == nil {
+		t.Fatalf("malformed vast normalized")
+	}
+}
+
+// inline or wrapper of ad with tracking urls
+type tracked struct {
+	Impressions []string `xml:"Impression"`
+	Tracking []struct {
+		Event string `xml:"event,attr"`
+		URL string `xml:",chardata"`
+	} `xml:"Creatives>Creative>Linear>TrackingEvents>Tracking"`
+}
+
+// urls of impressions and tracking events of document
+func trackers(t *testing.T, doc string) (impressions []string, events map[string][]string) {
+
+	parsed := struct {
+		Ads []struct {
+			InLine *tracked `xml:"InLine"`
+			Wrapper *tracked `xml:"Wrapper"`
+		} `xml:"Ad"`
+	}{}
+
+	if err := xml.Unmarshal([]byte(doc), &parsed); err != nil {
+		t.Fatalf("injected document is not xml: %s\n%s", err, doc)
+	}
+
+	events = make(map[string][]string)
+	for _, ad := range parsed.Ads {
+		for _, body := range []*tracked{ad.InLine, ad.Wrapper} {
+			if body == nil {
+				continue
+			}
+			impressions = append(impressions, body.Impressions...)
+			for _, tr := range body.Tracking {
+				events[tr.Event] = append(events[tr.Event], strings.TrimSpace(tr.URL))
+			}
+		}
+	}
+
+	return
+}
+
+func TestInject(t *testing.T) {
+
+	events := map[string][]string{
+		EVENT_START: {"http://exchange/event?e=start&a=$1"},
+		EVENT_COMPLETE: {"http://exchange/event?e=complete"},
+	}
+
+	cases := []struct {
+		name string
+		doc string
+		impressions int
+		starts int
+	}{
+		{"inline without tracking events", TEST_INLINE, 1, 1},
+		{"wrapper with tracking events", TEST_WRAPPER, 1, 2},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			doc := Inject(c.doc, []string{"http://exchange/imp?t=1&x=$0"}, events)
+			if err := Validate(doc); err != nil {
+				t.Fatalf("injected document is not valid: %s", err)
+			}
+
+			impressions, got := trackers(t, doc)
+			if len(impressions) != c.impressions || impressions[0] != "http://exchange/imp?t=1&x=$0" {
+				t.Fatalf("impressions %v", impressions)
+			}
+
+			if len(got[EVENT_START]) != c.starts || got[EVENT_START][0] != "http://exchange/event?e=start&a=$1" {
+				t.Fatalf("start trackers %v", got[EVENT_START])
+			}
+
+			if len(got[EVENT_COMPLETE]) != 1 {
+				t.Fatalf("complete trackers %v", got[EVENT_COMPLETE])
+			}
+		})
+	}
+}
+
+func TestInjectNothing(t *testing.T) {
+
+	if doc := Inject(TEST_INLINE, nil, nil); doc != TEST_INLINE {
+		t.Fatalf("document changed %q", doc)
+	}
+
+	// malformed document without ad tags is left as is
+	if doc := Inject(`<VAST version="3.0">`, []string{"http://exchange/imp"}, nil); doc != `<VAST version="3.0">` {
+		t.Fatalf("malformed document changed %q", doc)
+	}
+}
+
+func TestCanonicalEvents(t *testing.T) {
+
+	got := CanonicalEvents(map[string][]string{"firstquartile": {"a"}, "START": {"b"}, "custom": {"c"}})
+
+	for _, event := range []string{EVENT_FIRST_QUARTILE, EVENT_START, "custom"} {
+		if len(got[event]) != 1 {
+			t.Fatalf("event %s missing in %v", event, got)
+		}
+	}
+}
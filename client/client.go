@@ -1,6 +1,7 @@
 package client
 
 import (
+	"airpush/auction/openrtb"
 	"airpush/client/transport"
 	"context"
 	"fmt"
@@ -11,6 +12,12 @@ import (
 const CONN_TYPE_HTTP  = "http"
 const CONN_TYPE_GRPC  = "grpc"
 
+// dsp call exceeded client timeout
+var ErrTimeout = fmt.Errorf("request timeout")
+
+// openrtb version header sent to dsp
+const HEADER_OPENRTB_VERSION = "x-openrtb-version"
+
 // settings setter
 type ClientOption func(*Client)
 
@@ -58,34 +65,41 @@ func New(opts ...ClientOption) (proto *Client, err error) {
 	// init transport type
 	switch proto.cType {
 	case CONN_TYPE_HTTP:
-		proto.transport = transport.NewHttpTransport(transport.SetAddr(proto.addr))
+		proto.transport = transport.NewHttpTransport(
+			transport.SetAddr(proto.addr),
+			transport.SetHeader("Content-Type", "application/json"),
+			transport.SetHeader(HEADER_OPENRTB_VERSION, openrtb.VERSION),
+		)
 	case CONN_TYPE_GRPC:
-		err = fmt.Errorf("grpc transprt no implement")
+		proto.transport, err = transport.NewGrpcTransport(
+			transport.SetGrpcAddr(proto.addr),
+			transport.SetGrpcVersion(openrtb.VERSION),
+			transport.SetGrpcTimeout(proto.timeout),
+		)
+	default:
+		err = fmt.Errorf("unknown connection type %q", proto.cType)
 	}
 
-
 	return
 }
 
 // execute request
-func (c *Client) Do() (buf []byte, err error){
-
-	done := make(chan bool)
+// call is bounded by client timeout and canceled with parent context
+func (c *Client) Do(ctx context.Context, body []byte) (buf []byte, err error){
 
 	// timeout
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	go func() {
-		buf, err = c.transport.Do(ctx)
-		done <- true
-	}()
-
-	select {
-	case <-done:
-		return
-	case <-ctx.Done():
-		err = fmt.Errorf("requet timeout")
-		return
+	buf, err = c.transport.Do(ctx, body)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		err = ErrTimeout
 	}
+
+	return
+}
+
+// close transport connections
+func (c *Client) Close() error {
+	return c.transport.Close()
 }
\ No newline at end of file
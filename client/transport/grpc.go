@@ -0,0 +1,97 @@
+package transport
+
+import (
+	"airpush/client/transport/pb"
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// default deadline when parent context has none
+const GRPC_DEFAULT_TIMEOUT = time.Duration(100) * time.Millisecond
+
+// settings setter
+type GrpcTransportOption func(*BaseGrpcTransport)
+
+// addr host:port
+func SetGrpcAddr(addr string) GrpcTransportOption {
+	return func(t *BaseGrpcTransport) {
+		t.addr = addr
+	}
+}
+
+// openrtb version sent with every request
+func SetGrpcVersion(version string) GrpcTransportOption {
+	return func(t *BaseGrpcTransport) {
+		t.version = version
+	}
+}
+
+// deadline used when parent context has none
+func SetGrpcTimeout(duration time.Duration) GrpcTransportOption {
+	return func(t *BaseGrpcTransport) {
+		t.timeout = duration
+	}
+}
+
+// BaseGrpcTransport
+// one connection per dsp, reused by all auctions
+type BaseGrpcTransport struct {
+	conn *grpc.ClientConn
+	client pb.BidderClient
+	addr string
+	version string
+	timeout time.Duration
+}
+
+// NewGrpcTransport
+func NewGrpcTransport(opts ...GrpcTransportOption) (proto *BaseGrpcTransport, err error) {
+
+	proto = &BaseGrpcTransport{
+		timeout: GRPC_DEFAULT_TIMEOUT,
+	}
+
+	// set custom transport params
+	for _, opt := range opts {
+		opt(proto)
+	}
+
+	// connection established lazily and kept open
+	proto.conn, err = grpc.NewClient(proto.addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	proto.client = pb.NewBidderClient(proto.conn)
+
+	return
+}
+
+// transport.Do
+// empty result without error means no bid
+func (t *BaseGrpcTransport) Do(ctx context.Context, body []byte) ([]byte, error) {
+
+	// deadline inherit from auction, fallback on own timeout
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+		defer cancel()
+	}
+
+	res, err := t.client.Bid(ctx, &pb.BidRequest{
+		Version: t.version,
+		Payload: body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetPayload(), nil
+}
+
+// close connection
+func (t *BaseGrpcTransport) Close() error {
+	return t.conn.Close()
+}
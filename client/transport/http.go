@@ -1,7 +1,9 @@
 package transport
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 )
@@ -16,6 +18,13 @@ func SetAddr(addr string) HttpTransportOption {
 	}
 }
 
+// header sent with every request
+func SetHeader(key, value string) HttpTransportOption {
+	return func(t *BaseHttpTransport) {
+		t.header.Set(key, value)
+	}
+}
+
 // context
 func WithContext(ctx context.Context) HttpTransportOption {
 	return func(t *BaseHttpTransport) {
@@ -27,6 +36,7 @@ func WithContext(ctx context.Context) HttpTransportOption {
 type BaseHttpTransport struct {
 	client *http.Client
 	addr string
+	header http.Header
 	ctx context.Context
 }
 
@@ -35,6 +45,7 @@ func NewHttpTransport(opts ...HttpTransportOption) (proto *BaseHttpTransport) {
 
 	proto = &BaseHttpTransport{
 		ctx: context.Background(),
+		header: http.Header{},
 	}
 
 	// set custom transport params
@@ -62,14 +73,19 @@ func NewHttpTransport(opts ...HttpTransportOption) (proto *BaseHttpTransport) {
 }
 
 // transport.Do
-func (t *BaseHttpTransport) Do(ctx context.Context) ([]byte, error) {
+// body is posted to dsp, empty result without error means no content
+func (t *BaseHttpTransport) Do(ctx context.Context, body []byte) ([]byte, error) {
 
 	// init request
-	req, err := http.NewRequest(http.MethodGet, t.addr, nil)
+	req, err := http.NewRequest(http.MethodPost, t.addr, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
+	for key, values := range t.header {
+		req.Header[key] = values
+	}
+
 	// inherit parent context
 	req = req.WithContext(ctx)
 	res, err := t.client.Do(req)
@@ -77,14 +93,28 @@ func (t *BaseHttpTransport) Do(ctx context.Context) ([]byte, error) {
 		return nil, err
 	}
 
-	body, err := ioutil.ReadAll(res.Body)
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected status: %d", res.StatusCode)
+	}
+
+	buf, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	defer func() {
-		_ = res.Body.Close()
-	}()
+	return buf, nil
+}
 
-	return body, nil
+// close idle connections
+func (t *BaseHttpTransport) Close() error {
+	t.client.CloseIdleConnections()
+	return nil
 }
\ No newline at end of file
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.27.1
+// source: bid.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Bidder_Bid_FullMethodName = "/airpush.bid.Bidder/Bid"
+)
+
+// BidderClient is the client API for Bidder service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// dsp bid service
+type BidderClient interface {
+	// single openrtb auction round
+	Bid(ctx context.Context, in *BidRequest, opts ...grpc.CallOption) (*BidResponse, error)
+}
+
+type bidderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBidderClient(cc grpc.ClientConnInterface) BidderClient {
+	return &bidderClient{cc}
+}
+
+func (c *bidderClient) Bid(ctx context.Context, in *BidRequest, opts ...grpc.CallOption) (*BidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BidResponse)
+	err := c.cc.Invoke(ctx, Bidder_Bid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BidderServer is the server API for Bidder service.
+// All implementations must embed UnimplementedBidderServer
+// for forward compatibility.
+//
+// dsp bid service
+type BidderServer interface {
+	// single openrtb auction round
+	Bid(context.Context, *BidRequest) (*BidResponse, error)
+	mustEmbedUnimplementedBidderServer()
+}
+
+// UnimplementedBidderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBidderServer struct{}
+
+func (UnimplementedBidderServer) Bid(context.Context, *BidRequest) (*BidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+}
+func (UnimplementedBidderServer) mustEmbedUnimplementedBidderServer() {}
+func (UnimplementedBidderServer) testEmbeddedByValue()                {}
+
+// UnsafeBidderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BidderServer will
+// result in compilation errors.
+type UnsafeBidderServer interface {
+	mustEmbedUnimplementedBidderServer()
+}
+
+func RegisterBidderServer(s grpc.ServiceRegistrar, srv BidderServer) {
+	// If the following call pancis, it indicates UnimplementedBidderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Bidder_ServiceDesc, srv)
+}
+
+func _Bidder_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidderServer).Bid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bidder_Bid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidderServer).Bid(ctx, req.(*BidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bidder_ServiceDesc is the grpc.ServiceDesc for Bidder service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bidder_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "airpush.bid.Bidder",
+	HandlerType: (*BidderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bid",
+			Handler:    _Bidder_Bid_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bid.proto",
+}
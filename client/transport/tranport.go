@@ -4,5 +4,6 @@ import "context"
 
 // interface for GRPC/HTTP connection
 type Transport interface {
-	Do(ctx context.Context) ([]byte, error)
+	Do(ctx context.Context, body []byte) ([]byte, error)
+	Close() error
 }
\ No newline at end of file
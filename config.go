@@ -0,0 +1,405 @@
+package main
+
+import (
+	"airpush/auction"
+	"airpush/auction/dsp"
+	"airpush/auction/event"
+	"airpush/auction/quality"
+	"airpush/auction/vast"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// buffer size limits of server connections
+const MIN_BUFFER_SIZE = 512
+const MAX_BUFFER_SIZE = 1 << 20
+
+// auction records sinks
+const SINK_FILE = "file"
+const SINK_KAFKA = "kafka"
+
+// min length of tracking secret
+const MIN_SECRET_SIZE = 16
+
+// iso 4217 currency code
+var currencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Config typed app settings, timeouts in millisecond
+type Config struct {
+	Setting  SettingConfig  `mapstructure:"setting"`
+	Server   ServerConfig   `mapstructure:"server"`
+	Notice   NoticeConfig   `mapstructure:"notice"`
+	Currency CurrencyConfig `mapstructure:"currency"`
+	Vast     VastConfig     `mapstructure:"vast"`
+	Auction  AuctionConfig  `mapstructure:"auction"`
+	Events   EventsConfig   `mapstructure:"events"`
+	Tracking TrackingConfig `mapstructure:"tracking"`
+}
+
+// SettingConfig process settings
+type SettingConfig struct {
+	Mode    string `mapstructure:"mode"`
+	CpuCore int    `mapstructure:"cpu_core"`
+	Watch   bool   `mapstructure:"watch"`
+}
+
+// ServerConfig http server settings
+type ServerConfig struct {
+	ServerAddr       string `mapstructure:"ServerAddr"`
+	ReadTimeout      int    `mapstructure:"ReadTimeout"`
+	WriteTimeout     int    `mapstructure:"WriteTimeout"`
+	ReadBufferSize   int    `mapstructure:"ReadBufferSize"`
+	WriteBufferSize  int    `mapstructure:"WriteBufferSize"`
+	Concurrency      int    `mapstructure:"Concurrency"`
+	DisableKeepalive bool   `mapstructure:"DisableKeepalive"`
+	AdminToken       string `mapstructure:"AdminToken"`
+	GrpcBidderAddr   string `mapstructure:"GrpcBidderAddr"`
+	KafkaBrokerAddr  string `mapstructure:"KafkaBrokerAddr"`
+	Metrics          bool   `mapstructure:"Metrics"`
+}
+
+// NoticeConfig win/loss notices settings
+type NoticeConfig struct {
+	Workers int `mapstructure:"workers"`
+	Queue   int `mapstructure:"queue"`
+	Retries int `mapstructure:"retries"`
+	Timeout int `mapstructure:"timeout"`
+}
+
+// CurrencyConfig exchange currency settings, refresh in second
+type CurrencyConfig struct {
+	Base    string `mapstructure:"base"`
+	Rates   string `mapstructure:"rates"`
+	Refresh int    `mapstructure:"refresh"`
+}
+
+// VastConfig video settings, ttl in second
+type VastConfig struct {
+	TTL         int                 `mapstructure:"ttl"`
+	Size        int                 `mapstructure:"size"`
+	Impressions []string            `mapstructure:"impressions"`
+	Events      map[string][]string `mapstructure:"events"`
+}
+
+// AuctionConfig auction settings
+type AuctionConfig struct {
+	Timeout   int                   `mapstructure:"timeout"`
+	Type      string                `mapstructure:"type"`
+	Increment float64               `mapstructure:"increment"`
+	Floors    string                `mapstructure:"floors"`
+	Deals     string                `mapstructure:"deals"`
+	Quality   *QualityConfig        `mapstructure:"quality"`
+	DspStore  string                `mapstructure:"dsp_store"`
+	Dsp       map[string]dsp.Config `mapstructure:"dsp"`
+}
+
+// QualityConfig ad quality settings, no block disable checks
+type QualityConfig struct {
+	Checks    []string `mapstructure:"checks"`
+	Creatives []string `mapstructure:"creatives"`
+}
+
+// EventsConfig auction and notice events sink settings
+type EventsConfig struct {
+	Sink  string           `mapstructure:"sink"`
+	File  EventFileConfig  `mapstructure:"file"`
+	Kafka EventKafkaConfig `mapstructure:"kafka"`
+}
+
+// EventFileConfig json lines files, max_size in megabyte, interval in second, flush in millisecond
+type EventFileConfig struct {
+	Dir      string `mapstructure:"dir"`
+	Prefix   string `mapstructure:"prefix"`
+	Queue    int    `mapstructure:"queue"`
+	MaxSize  int    `mapstructure:"max_size"`
+	Interval int    `mapstructure:"interval"`
+	Flush    int    `mapstructure:"flush"`
+}
+
+// EventKafkaConfig kafka producer, batch_timeout in millisecond
+type EventKafkaConfig struct {
+	Brokers      []string `mapstructure:"brokers"`
+	Topic        string   `mapstructure:"topic"`
+	Queue        int      `mapstructure:"queue"`
+	InFlight     int      `mapstructure:"in_flight"`
+	BatchSize    int      `mapstructure:"batch_size"`
+	BatchTimeout int      `mapstructure:"batch_timeout"`
+	Compression  string   `mapstructure:"compression"`
+	Retries      int      `mapstructure:"retries"`
+}
+
+// TrackingConfig encrypted tracking urls, empty secret disable tracking, ttl and dedupe_ttl in second
+type TrackingConfig struct {
+	URL        string `mapstructure:"url"`
+	Secret     string `mapstructure:"secret"`
+	TTL        int    `mapstructure:"ttl"`
+	DedupeTTL  int    `mapstructure:"dedupe_ttl"`
+	DedupeSize int    `mapstructure:"dedupe_size"`
+}
+
+// decode config of app
+func loadConfig(v *viper.Viper) (*Config, error) {
+
+	// whole config is decoded, nested key of app block miss env overrides
+	root := struct {
+		App *Config `mapstructure:"app"`
+	}{App: new(Config)}
+
+	err := v.Unmarshal(&root)
+	if err != nil {
+		return nil, fmt.Errorf("decode config err: %s", err)
+	}
+	c := root.App
+
+	// empty block is not decoded as set
+	if c.Auction.Quality == nil && v.IsSet("app.auction.quality") {
+		c.Auction.Quality = new(QualityConfig)
+	}
+
+	return c, nil
+}
+
+// replace dsp list with store file of admin api if exists
+func (c *Config) useStore(path string) error {
+
+	if !storeUsed(path) {
+		return nil
+	}
+
+	list, err := dsp.Load(path)
+	if err != nil {
+		return fmt.Errorf("load dsp store %s err: %s", path, err)
+	}
+
+	c.Auction.Dsp = make(map[string]dsp.Config, len(list))
+	for _, d := range list {
+		c.Auction.Dsp[d.Name] = d
+	}
+
+	return nil
+}
+
+// is store file of admin api used instead of app.auction.dsp
+func storeUsed(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// dsp settings sorted by name
+func (c *Config) Dsps() []dsp.Config {
+	return dsp.Configs(c.Auction.Dsp)
+}
+
+// all problems of config, empty when valid
+func (c *Config) Validate() (res []error) {
+
+	add := func(key string, format string, args ...interface{}) {
+		res = append(res, fmt.Errorf("app.%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	// setting
+	if c.Setting.CpuCore < 0 {
+		add("setting.cpu_core", "must not be negative, got %d", c.Setting.CpuCore)
+	}
+
+	// server
+	if _, _, err := net.SplitHostPort(c.Server.ServerAddr); err != nil {
+		add("server.ServerAddr", "%q is not host:port", c.Server.ServerAddr)
+	}
+	if c.Server.GrpcBidderAddr != "" {
+		if _, _, err := net.SplitHostPort(c.Server.GrpcBidderAddr); err != nil {
+			add("server.GrpcBidderAddr", "%q is not host:port", c.Server.GrpcBidderAddr)
+		}
+	}
+	if c.Server.KafkaBrokerAddr != "" {
+		if _, _, err := net.SplitHostPort(c.Server.KafkaBrokerAddr); err != nil {
+			add("server.KafkaBrokerAddr", "%q is not host:port", c.Server.KafkaBrokerAddr)
+		}
+	}
+	if c.Server.ReadTimeout <= 0 {
+		add("server.ReadTimeout", "must be positive, got %d", c.Server.ReadTimeout)
+	}
+	if c.Server.WriteTimeout <= 0 {
+		add("server.WriteTimeout", "must be positive, got %d", c.Server.WriteTimeout)
+	}
+	if s := c.Server.ReadBufferSize; s < MIN_BUFFER_SIZE || s > MAX_BUFFER_SIZE {
+		add("server.ReadBufferSize", "%d is out of %d..%d", s, MIN_BUFFER_SIZE, MAX_BUFFER_SIZE)
+	}
+	if s := c.Server.WriteBufferSize; s < MIN_BUFFER_SIZE || s > MAX_BUFFER_SIZE {
+		add("server.WriteBufferSize", "%d is out of %d..%d", s, MIN_BUFFER_SIZE, MAX_BUFFER_SIZE)
+	}
+	if c.Server.Concurrency < 0 {
+		add("server.Concurrency", "must not be negative, got %d", c.Server.Concurrency)
+	}
+
+	// notice
+	if c.Notice.Workers <= 0 {
+		add("notice.workers", "must be positive, got %d", c.Notice.Workers)
+	}
+	if c.Notice.Queue <= 0 {
+		add("notice.queue", "must be positive, got %d", c.Notice.Queue)
+	}
+	if c.Notice.Retries < 0 {
+		add("notice.retries", "must not be negative, got %d", c.Notice.Retries)
+	}
+	if c.Notice.Timeout <= 0 {
+		add("notice.timeout", "must be positive, got %d", c.Notice.Timeout)
+	}
+
+	// currency
+	if !currencyCode.MatchString(c.Currency.Base) {
+		add("currency.base", "%q is not iso 4217 code", c.Currency.Base)
+	}
+	if c.Currency.Rates != "" {
+		if _, err := os.Stat(c.Currency.Rates); err != nil {
+			add("currency.rates", "%s", err)
+		}
+	}
+	if c.Currency.Refresh < 0 {
+		add("currency.refresh", "must not be negative, got %d", c.Currency.Refresh)
+	}
+
+	// vast
+	if c.Vast.TTL <= 0 {
+		add("vast.ttl", "must be positive, got %d", c.Vast.TTL)
+	}
+	if c.Vast.Size <= 0 {
+		add("vast.size", "must be positive, got %d", c.Vast.Size)
+	}
+	for _, u := range c.Vast.Impressions {
+		if !isURL(u) {
+			add("vast.impressions", "%q is not http(s) url", u)
+		}
+	}
+	events := make([]string, 0, len(c.Vast.Events))
+	for event := range c.Vast.Events {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+	for _, event := range events {
+		urls := c.Vast.Events[event]
+		if !containsFold(vast.EVENTS, event) {
+			add("vast.events."+event, "unknown event, expected one of %s", strings.Join(vast.EVENTS, ", "))
+		}
+		for _, u := range urls {
+			if !isURL(u) {
+				add("vast.events."+event, "%q is not http(s) url", u)
+			}
+		}
+	}
+
+	// auction
+	a := c.Auction
+	if a.Timeout <= 0 {
+		add("auction.timeout", "must be positive, got %d", a.Timeout)
+	}
+	if a.Type != "" && a.Type != auction.TYPE_FIRST_PRICE && a.Type != auction.TYPE_SECOND_PRICE {
+		add("auction.type", "unknown type %q, expected %s or %s", a.Type, auction.TYPE_FIRST_PRICE, auction.TYPE_SECOND_PRICE)
+	}
+	if a.Increment < 0 {
+		add("auction.increment", "must not be negative, got %v", a.Increment)
+	}
+	if a.Floors != "" {
+		if _, err := os.Stat(a.Floors); err != nil {
+			add("auction.floors", "%s", err)
+		}
+	}
+	if a.Deals != "" {
+		if _, err := os.Stat(a.Deals); err != nil {
+			add("auction.deals", "%s", err)
+		}
+	}
+	if a.Quality != nil {
+		for _, check := range a.Quality.Checks {
+			if !quality.IsCheck(check) {
+				add("auction.quality.checks", "unknown check %q, expected one of %s", check, strings.Join(quality.CHECKS, ", "))
+			}
+		}
+	}
+
+	// events
+	switch c.Events.Sink {
+	case "":
+	case SINK_FILE:
+		f := c.Events.File
+		if f.Dir == "" {
+			add("events.file.dir", "is empty")
+		}
+		if f.Queue < 0 || f.MaxSize < 0 || f.Interval < 0 || f.Flush < 0 {
+			add("events.file", "queue, max_size, interval and flush must not be negative")
+		}
+	case SINK_KAFKA:
+		k := c.Events.Kafka
+		if len(k.Brokers) == 0 {
+			add("events.kafka.brokers", "is empty")
+		}
+		for _, b := range k.Brokers {
+			if _, _, err := net.SplitHostPort(b); err != nil {
+				add("events.kafka.brokers", "%q is not host:port", b)
+			}
+		}
+		if k.Topic == "" {
+			add("events.kafka.topic", "is empty")
+		}
+		if k.Queue < 0 || k.InFlight < 0 || k.BatchSize < 0 || k.BatchTimeout < 0 || k.Retries < 0 {
+			add("events.kafka", "queue, in_flight, batch_size, batch_timeout and retries must not be negative")
+		}
+		if _, err := event.Compression(k.Compression); err != nil {
+			add("events.kafka.compression", "%s", err)
+		}
+	default:
+		add("events.sink", "unknown sink %q, expected %s, %s or empty", c.Events.Sink, SINK_FILE, SINK_KAFKA)
+	}
+
+	// tracking
+	if t := c.Tracking; t.Secret != "" {
+		if !isURL(t.URL) {
+			add("tracking.url", "%q is not http(s) url", t.URL)
+		}
+		if len(t.Secret) < MIN_SECRET_SIZE {
+			add("tracking.secret", "shorter than %d characters", MIN_SECRET_SIZE)
+		}
+		if t.TTL < 0 || t.DedupeTTL < 0 || t.DedupeSize < 0 {
+			add("tracking", "ttl, dedupe_ttl and dedupe_size must not be negative")
+		}
+	}
+
+	// dsp
+	if len(a.Dsp) == 0 {
+		add("auction.dsp", "no dsp configured")
+	}
+	for _, d := range c.Dsps() {
+		res = append(res, d.Check()...)
+		if a.Timeout > 0 && d.Timeout > a.Timeout {
+			add("auction.dsp."+d.Name+".timeout", "%d exceed auction timeout %d", d.Timeout, a.Timeout)
+		}
+	}
+
+	return
+}
+
+// absolute http(s) url
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// list contain value, case insensitive
+func containsFold(list []string, v string) bool {
+	for _, s := range list {
+		if strings.EqualFold(s, v) {
+			return true
+		}
+	}
+	return false
+}
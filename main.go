@@ -2,8 +2,14 @@ package main
 
 import (
 	"airpush/auction"
+	"airpush/auction/currency"
+	"airpush/auction/deal"
 	"airpush/auction/dsp"
-	"airpush/client"
+	"airpush/auction/floor"
+	"airpush/auction/metrics"
+	"airpush/auction/notice"
+	"airpush/auction/tracking"
+	"airpush/auction/vast"
 	"airpush/server"
 	"bufio"
 	"flag"
@@ -14,6 +20,8 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -21,15 +29,11 @@ import (
 const APP_NAME = "rtb"
 
 // load config
-func initConfig() (v *viper.Viper, err error) {
-
-	var path string
-
-	flag.StringVar(&path, "config", "", "config path")
-	flag.Parse()
+func initConfig(path string) (v *viper.Viper, err error) {
 
 	v = viper.New()
 	v.SetEnvPrefix(APP_NAME)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 	v.SetConfigName("config")
 
@@ -45,22 +49,72 @@ func initConfig() (v *viper.Viper, err error) {
 	return
 }
 
+// decode and validate config, dsp list of store file has priority over config
+func checkConfig(v *viper.Viper, store string) (*Config, []error) {
+
+	conf, err := loadConfig(v)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = conf.useStore(store)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return conf, conf.Validate()
+}
+
+// buffered log output, flushed when lines must show at once
+type logWriter struct {
+	mu sync.Mutex
+	w *bufio.Writer
+}
+
+func (l *logWriter) Write(p []byte) (int, error) {
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	return l.w.Write(p)
+}
+
+func (l *logWriter) Flush() error {
+	defer l.mu.Unlock()
+	l.mu.Lock()
+
+	return l.w.Flush()
+}
+
+// flush buffered log output
+func flushLog(logger *logrus.Logger) {
+	if w, ok := logger.Out.(*logWriter); ok {
+		_ = w.Flush()
+	}
+}
+
 // init app settings
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
 
-// loop app
-func loop(exit func(os.Signal)) {
+// loop app, SIGHUP reload config
+func loop(exit func(os.Signal), reload func()) {
 	signalCh := make(chan os.Signal, 2)
-	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
-	switch sig := <-signalCh; sig {
-	case os.Interrupt:
-		exit(sig)
-	case syscall.SIGTERM:
-		exit(sig)
-	case syscall.SIGQUIT:
-		exit(sig)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGHUP)
+	for {
+		switch sig := <-signalCh; sig {
+		case syscall.SIGHUP:
+			reload()
+		case os.Interrupt:
+			exit(sig)
+			return
+		case syscall.SIGTERM:
+			exit(sig)
+			return
+		case syscall.SIGQUIT:
+			exit(sig)
+			return
+		}
 	}
 }
 
@@ -68,65 +122,189 @@ func loop(exit func(os.Signal)) {
 func main()  {
 
 
+	// encrypt/decrypt test values of price macro
+	if len(os.Args) > 1 && os.Args[1] == "price" {
+		os.Exit(priceCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
+	var path string
+	var check bool
+
+	flag.StringVar(&path, "config", "", "config path")
+	flag.BoolVar(&check, "check-config", false, "report all config problems and exit")
+	flag.Parse()
+
 	// init logger
 	logger := logrus.New()
 
 	// init config
-	config, err := initConfig()
+	config, err := initConfig(path)
 	if err != nil {
-		logger.Fatal("load config fail, err: %s", err)
-	} else {
+		logger.Fatalf("load config fail, err: %s", err)
+	}
+
+	conf, problems := checkConfig(config, config.GetString("app.auction.dsp_store"))
 
-		// setting how max app used cpu core
-		if c := config.GetInt("app.setting.cpu_core"); c != 0 {
-			runtime.GOMAXPROCS(config.GetInt("app.setting.cpu_core"))
-		} else {
-			runtime.GOMAXPROCS(runtime.NumCPU())
+	// only report config problems
+	if check {
+		for _, p := range problems {
+			fmt.Fprintln(os.Stderr, p)
 		}
+		if len(problems) > 0 {
+			fmt.Fprintf(os.Stderr, "config %s has %d problems\n", config.ConfigFileUsed(), len(problems))
+			os.Exit(1)
+		}
+		fmt.Printf("config %s is valid\n", config.ConfigFileUsed())
+		os.Exit(0)
+	}
 
-		// setting logger level
-		// logger have many hooks graylog/telegram/etc... I think is nice
-		if c := config.GetString("app.setting.mode"); c != "debug" {
-			logger.SetOutput(bufio.NewWriterSize(os.Stdout, 1024*16)) // 16kb pre buffer output
-			logger.SetLevel(logrus.ErrorLevel)
-		} else {
-			logger.SetOutput(os.Stdout)
-			logger.SetLevel(logrus.TraceLevel)
+	if len(problems) > 0 {
+		for _, p := range problems {
+			logger.Error(p)
 		}
+		logger.Fatalf("invalid config %s, %d problems", config.ConfigFileUsed(), len(problems))
+	}
+
+	// admin api store replace dsp list of config
+	if store := conf.Auction.DspStore; storeUsed(store) && len(config.GetStringMap("app.auction.dsp")) > 0 {
+		logger.Warnf("app.auction.dsp is shadowed by dsp store %s, config dsp settings are ignored", store)
+	}
+
+	// setting how max app used cpu core
+	if c := conf.Setting.CpuCore; c != 0 {
+		runtime.GOMAXPROCS(c)
+	} else {
+		runtime.GOMAXPROCS(runtime.NumCPU())
+	}
+
+	// setting logger level
+	// logger have many hooks graylog/telegram/etc... I think is nice
+	if conf.Setting.Mode != "debug" {
+		logger.SetOutput(&logWriter{w: bufio.NewWriterSize(os.Stdout, 1024*16)}) // 16kb pre buffer output
+		logger.SetLevel(logrus.WarnLevel) // config reload and store warnings are kept
+	} else {
+		logger.SetOutput(os.Stdout)
+		logger.SetLevel(logrus.TraceLevel)
 	}
 
 	// build dsp and custom clients
 	var dsps []*dsp.Dsp
-	for name, _ := range config.GetStringMap("app.auction.dsp") {
-
-		c, err := client.New(
-			client.SetAddr(config.GetString(fmt.Sprintf("app.auction.dsp.%s.addr", name))),
-			client.SetConnectionType(config.GetString(fmt.Sprintf("app.auction.dsp.%s.type", name))),
-			client.WithTimeout(config.GetDuration(fmt.Sprintf("app.auction.dsp.%s.timeout", name)) * time.Millisecond),
-		)
+	for _, c := range conf.Dsps() {
+		d, err := dsp.Build(c)
 		if err != nil {
-			logger.Fatalf("init client %s err: %s", name, err)
+			logger.Fatalf("init dsp %s err: %s", c.Name, err)
 		}
+		dsps = append(dsps, d)
+	}
+	pool := dsp.NewPool(dsp.SetList(dsps), dsp.SetPath(conf.Auction.DspStore))
 
-		dsps = append(dsps, dsp.New(name, c))
+	// exchange rates
+	rates := currency.New(
+		currency.SetBase(conf.Currency.Base),
+		currency.SetPath(conf.Currency.Rates),
+		currency.SetRefresh(time.Duration(conf.Currency.Refresh) * time.Second),
+		currency.SetLogger(logger),
+	)
+	err = rates.Load()
+	if err != nil {
+		logger.Fatalf("load rates err: %s", err)
 	}
+	rates.Start()
+
+	// floor rules and private marketplace deals
+	floors := floor.New()
+	deals := deal.New(deal.SetCur(rates.GetBase()))
+	rules, dealList, err := readRules(conf)
+	if err != nil {
+		logger.Fatalf("%s", err)
+	}
+	floors.Set(rules)
+	deals.Set(dealList)
+
+	// winning vast documents
+	vastStore := vast.NewStore(
+		vast.SetTTL(time.Duration(conf.Vast.TTL) * time.Second),
+		vast.SetSize(conf.Vast.Size),
+	)
+
+	// prometheus metrics
+	var m *metrics.Metrics
+	if conf.Server.Metrics {
+		m = metrics.New()
+	}
+
+	// auction and notice events
+	events, err := newEvents(conf, logger)
+	if err != nil {
+		logger.Fatalf("init events err: %s", err)
+	}
+
+	// win/loss notices
+	notifier := notice.New(
+		notice.SetWorkers(conf.Notice.Workers),
+		notice.SetQueueSize(conf.Notice.Queue),
+		notice.SetRetries(conf.Notice.Retries),
+		notice.SetTimeout(time.Duration(conf.Notice.Timeout) * time.Millisecond),
+		notice.SetLogger(logger),
+		notice.SetEvents(events),
+	)
+	notifier.Start()
+
+	// encrypted impression, click and event tracking, disabled without secret
+	var tracker *tracking.Tracker
+	if c := conf.Tracking; c.Secret != "" {
+		tracker = tracking.New(
+			tracking.SetSecret(c.Secret),
+			tracking.SetURL(c.URL),
+			tracking.SetTTL(time.Duration(c.TTL) * time.Second),
+			tracking.SetDedupeTTL(time.Duration(c.DedupeTTL) * time.Second),
+			tracking.SetDedupeSize(c.DedupeSize),
+		)
+	}
+
+	// state kept across config reloads
+	a := &app{
+		config: config,
+		logger: logger,
+		pool: pool,
+		floors: floors,
+		deals: deals,
+		rates: rates,
+		vast: vastStore,
+		metrics: m,
+		notifier: notifier,
+		stats: auction.NewStats(),
+		events: events,
+		tracker: tracker,
+	}
+	a.queues()
 
 	// init server
 	s, err := server.New(
 
-		server.SetAuction(auction.New(auction.SetDsp(dsps), auction.SetTimeout(config.GetDuration("app.auction.timeout") * time.Millisecond))),
+		server.SetAuction(a.newAuction(conf)),
+		server.SetFloors(floors),
+		server.SetDeals(deals),
+		server.SetRates(rates),
+		server.SetVast(vastStore),
+		server.SetMetrics(m),
+		server.SetVastTracking(conf.Vast.Impressions, vast.CanonicalEvents(conf.Vast.Events)),
+		server.SetAdminToken(conf.Server.AdminToken),
+		server.SetTracker(tracker),
+		server.SetEvents(events),
+		server.SetNotifier(notifier),
 
-		server.SetConcurrency(config.GetInt("app.server.Concurrency")),
-		server.SetDisableKeepalive(config.GetBool("app.server.DisableKeepalive")),
+		server.SetConcurrency(conf.Server.Concurrency),
+		server.SetDisableKeepalive(conf.Server.DisableKeepalive),
 
-		server.SetReadBufferSize(config.GetInt("app.server.ReadBufferSize")),
-		server.SetWriteBufferSize(config.GetInt("app.server.WriteBufferSize")),
+		server.SetReadBufferSize(conf.Server.ReadBufferSize),
+		server.SetWriteBufferSize(conf.Server.WriteBufferSize),
 
-		server.SetWriteTimeout(config.GetInt("app.server.WriteTimeout")),
-		server.SetReadTimeout(config.GetInt("app.server.ReadTimeout")),
+		server.SetWriteTimeout(conf.Server.WriteTimeout),
+		server.SetReadTimeout(conf.Server.ReadTimeout),
 
 		server.SetServerName("simple rtb"),
-		server.SetServerAddr(config.GetString("app.server.ServerAddr")),
+		server.SetServerAddr(conf.Server.ServerAddr),
 		server.SetLogger(logger),
 	)
 	if err != nil {
@@ -141,12 +319,63 @@ func main()  {
 		}
 	}()
 
+	// start fake grpc dsp
+	var bidder *server.GrpcBidder
+	if addr := conf.Server.GrpcBidderAddr; addr != "" {
+		bidder = server.NewGrpcBidder(addr, logger)
+		go func() {
+			err := bidder.Start()
+			if err != nil {
+				logger.Fatalf("start grpc bidder fail: %s", err)
+			}
+		}()
+	}
+
+	// start fake kafka
+	var broker *server.KafkaBroker
+	if addr := conf.Server.KafkaBrokerAddr; addr != "" {
+		broker = server.NewKafkaBroker(addr, []string{conf.Events.Kafka.Topic}, logger)
+		go func() {
+			err := broker.Start()
+			if err != nil {
+				logger.Fatalf("start fake kafka fail: %s", err)
+			}
+		}()
+	}
+
 	logger.Infof("app loaded with conf: %s", config.ConfigFileUsed())
 
+	// watch config file
+	if conf.Setting.Watch {
+		err = a.watch(s)
+		if err != nil {
+			logger.Errorf("watch config fail: %s", err)
+		}
+	}
+
 	// loop app
 	loop(func(i os.Signal) {
 		logger.Info("graceful shutdown...")
 		_ = s.Close()
+
+		if bidder != nil {
+			bidder.Close()
+		}
+
+		a.close()
+		notifier.Close()
+		if events != nil {
+			_ = events.Close()
+		}
+		if broker != nil {
+			broker.Close()
+		}
+		rates.Close()
+
+		pool.Close()
+		flushLog(logger)
+	}, func() {
+		a.reload(s)
 	})
 }
 
@@ -0,0 +1,3 @@
+wrk.method = "POST"
+wrk.body   = '{"id":"1","imp":[{"id":"1","banner":{"w":300,"h":250}}],"tmax":100}'
+wrk.headers["Content-Type"] = "application/json"
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"airpush/auction/dsp"
+	"airpush/auction/price"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// price subcommand actions
+const PRICE_ENCRYPT = "encrypt"
+const PRICE_DECRYPT = "decrypt"
+
+// encrypt or decrypt test values of ${AUCTION_PRICE}, return exit code
+// price encrypt|decrypt [-encryption-key k -integrity-key k | -dsp name] value
+func priceCommand(args []string, out, errOut io.Writer) int {
+
+	usage := func() int {
+		fmt.Fprintf(errOut, "usage: %s price %s|%s [flags] <cpm price|encrypted price>\n", os.Args[0], PRICE_ENCRYPT, PRICE_DECRYPT)
+		return 2
+	}
+
+	if len(args) == 0 || (args[0] != PRICE_ENCRYPT && args[0] != PRICE_DECRYPT) {
+		return usage()
+	}
+	action := args[0]
+
+	var path, name, ekey, ikey, rawIV string
+	fs := flag.NewFlagSet("price "+action, flag.ContinueOnError)
+	fs.SetOutput(errOut)
+	fs.StringVar(&ekey, "encryption-key", "", "encryption key, base64 or hex")
+	fs.StringVar(&ikey, "integrity-key", "", "integrity key, base64 or hex")
+	fs.StringVar(&name, "dsp", "", "take keys of dsp from config")
+	fs.StringVar(&path, "config", "", "config path used with -dsp")
+	fs.StringVar(&rawIV, "iv", "", "fixed iv of encryption, base64 or hex, random when empty")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		return usage()
+	}
+
+	// keys of configured dsp
+	if name != "" {
+		c, err := dspPrice(path, name)
+		if err != nil {
+			fmt.Fprintln(errOut, err)
+			return 1
+		}
+		ekey, ikey = c.EncryptionKey, c.IntegrityKey
+	}
+
+	crypter, err := newCrypter(ekey, ikey)
+	if err != nil {
+		fmt.Fprintln(errOut, err)
+		return 1
+	}
+
+	value := fs.Arg(0)
+	switch action {
+	case PRICE_ENCRYPT:
+		cpm, err := strconv.ParseFloat(value, 64)
+		if err != nil || cpm < 0 {
+			fmt.Fprintf(errOut, "price %q is not valid cpm price\n", value)
+			return 1
+		}
+		iv := price.NewIV()
+		if rawIV != "" {
+			iv, err = price.DecodeKey(rawIV)
+			if err != nil || len(iv) != price.IV_SIZE {
+				fmt.Fprintf(errOut, "iv must be %d bytes\n", price.IV_SIZE)
+				return 1
+			}
+		}
+		fmt.Fprintln(out, crypter.EncryptMicros(price.Micros(cpm), iv))
+
+	case PRICE_DECRYPT:
+		micros, _, err := crypter.DecryptMicros(value)
+		if err != nil {
+			fmt.Fprintln(errOut, err)
+			return 1
+		}
+		fmt.Fprintf(out, "%s (%d micros)\n", strconv.FormatFloat(float64(micros)/price.MICROS, 'f', -1, 64), micros)
+	}
+
+	return 0
+}
+
+// crypter of encoded keys
+func newCrypter(ekey, ikey string) (*price.Crypter, error) {
+
+	e, err := price.DecodeKey(ekey)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key %s", err)
+	}
+
+	i, err := price.DecodeKey(ikey)
+	if err != nil {
+		return nil, fmt.Errorf("integrity key %s", err)
+	}
+
+	return price.New(price.SetEncryptionKey(e), price.SetIntegrityKey(i))
+}
+
+// price keys of dsp in config or dsp store
+func dspPrice(path, name string) (*dsp.PriceConfig, error) {
+
+	v, err := initConfig(path)
+	if err != nil {
+		return nil, fmt.Errorf("load config fail, err: %s", err)
+	}
+
+	conf, err := loadConfig(v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conf.useStore(conf.Auction.DspStore)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range conf.Dsps() {
+		if c.Name == name {
+			if c.Price == nil {
+				return nil, fmt.Errorf("dsp %s has no price keys", name)
+			}
+			return c.Price, nil
+		}
+	}
+
+	return nil, fmt.Errorf("dsp %s not found", name)
+}
@@ -0,0 +1,291 @@
+package server
+
+import (
+	"airpush/auction/breaker"
+	"airpush/auction/deal"
+	"airpush/auction/dsp"
+	"airpush/auction/floor"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// admin auth middleware, token pass by Authorization: Bearer header
+func adminMiddleWare(token string, next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return fasthttp.RequestHandler(func(ctx *fasthttp.RequestCtx) {
+
+		// constant time compare, token is not guessed by response timing
+		expected := []byte("Bearer " + token)
+		if subtle.ConstantTimeCompare(ctx.Request.Header.Peek("Authorization"), expected) != 1 {
+			ctx.SetStatusCode(fasthttp.StatusUnauthorized)
+			return
+		}
+
+		next(ctx)
+	})
+}
+
+// write json answer
+func writeJson(ctx *fasthttp.RequestCtx, v interface{}) {
+
+	buf, err := json.Marshal(v)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	_, _ = ctx.Write(buf)
+}
+
+// write json error answer
+func writeError(ctx *fasthttp.RequestCtx, code int, err error) {
+	buf, _ := json.Marshal(map[string]string{"error": err.Error()})
+	ctx.SetStatusCode(code)
+	_, _ = ctx.Write(buf)
+}
+
+// status of failed dsp change, persist failure is server error
+func poolErrorCode(err error, code int) int {
+	if errors.Is(err, dsp.ErrPersist) {
+		return fasthttp.StatusInternalServerError
+	}
+	return code
+}
+
+// dsp timeout must not exceed auction timeout
+func (s *Server) checkDspTimeout(c dsp.Config) error {
+	if timeout := s.GetAuction().GetTimeout(); timeout > 0 && time.Duration(c.Timeout) * time.Millisecond > timeout {
+		return fmt.Errorf("dsp %s: timeout %d exceed auction timeout %d", c.Name, c.Timeout, timeout / time.Millisecond)
+	}
+	return nil
+}
+
+// auction counters
+func (s *Server) StatsRoute(ctx *fasthttp.RequestCtx) {
+	writeJson(ctx, s.GetAuction().GetStats().Get())
+}
+
+// circuit breakers state by dsp
+func (s *Server) BreakersRoute(ctx *fasthttp.RequestCtx) {
+
+	res := make(map[string]breaker.State)
+	for _, d := range s.GetAuction().GetDsp() {
+		if b := d.GetBreaker(); b != nil {
+			res[d.GetName()] = b.GetState()
+		}
+	}
+
+	writeJson(ctx, res)
+}
+
+// list floor rules
+func (s *Server) FloorsRoute(ctx *fasthttp.RequestCtx) {
+	writeJson(ctx, s.floors.Get())
+}
+
+// replace floor rules
+func (s *Server) UpdateFloorsRoute(ctx *fasthttp.RequestCtx) {
+
+	var rules []floor.Rule
+	err := json.Unmarshal(ctx.PostBody(), &rules)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	err = floor.Check(rules)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	s.floors.Set(rules)
+	writeJson(ctx, rules)
+}
+
+// list deals
+func (s *Server) DealsRoute(ctx *fasthttp.RequestCtx) {
+	writeJson(ctx, s.deals.Get())
+}
+
+// replace deals
+func (s *Server) UpdateDealsRoute(ctx *fasthttp.RequestCtx) {
+
+	var deals []deal.Deal
+	err := json.Unmarshal(ctx.PostBody(), &deals)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	err = deal.Check(deals)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	s.deals.Set(deals)
+	writeJson(ctx, deals)
+}
+
+// exchange currency and rates
+func (s *Server) RatesRoute(ctx *fasthttp.RequestCtx) {
+	writeJson(ctx, map[string]interface{}{
+		"base": s.rates.GetBase(),
+		"rates": s.rates.Get(),
+	})
+}
+
+// replace rates until next reload of rates file
+func (s *Server) UpdateRatesRoute(ctx *fasthttp.RequestCtx) {
+
+	var rates map[string]float64
+	err := json.Unmarshal(ctx.PostBody(), &rates)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	s.rates.Set(rates)
+	writeJson(ctx, s.rates.Get())
+}
+
+// list dsp settings, single dsp by name query arg
+func (s *Server) DspRoute(ctx *fasthttp.RequestCtx) {
+
+	pool := s.GetAuction().GetPool()
+
+	name := string(ctx.QueryArgs().Peek("name"))
+	if name == "" {
+		list := pool.GetConfig()
+		for i := range list {
+			list[i] = list[i].Masked()
+		}
+		writeJson(ctx, list)
+		return
+	}
+
+	d := pool.Find(name)
+	if d == nil {
+		writeError(ctx, fasthttp.StatusNotFound, fmt.Errorf("dsp %s not found", name))
+		return
+	}
+
+	writeJson(ctx, d.GetConfig().Masked())
+}
+
+// add dsp
+func (s *Server) CreateDspRoute(ctx *fasthttp.RequestCtx) {
+
+	var c dsp.Config
+	err := json.Unmarshal(ctx.PostBody(), &c)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	err = s.checkDspTimeout(c)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	pool := s.GetAuction().GetPool()
+	if pool.Find(c.Name) != nil {
+		writeError(ctx, fasthttp.StatusConflict, fmt.Errorf("dsp %s already exists", c.Name))
+		return
+	}
+
+	err = pool.Create(c)
+	if err != nil {
+		writeError(ctx, poolErrorCode(err, fasthttp.StatusBadRequest), err)
+		return
+	}
+
+	s.logger.Printf("admin: dsp %s created", c.Name)
+	writeJson(ctx, pool.Find(c.Name).GetConfig().Masked())
+}
+
+// replace dsp settings
+func (s *Server) UpdateDspRoute(ctx *fasthttp.RequestCtx) {
+
+	var c dsp.Config
+	err := json.Unmarshal(ctx.PostBody(), &c)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	err = s.checkDspTimeout(c)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusBadRequest, err)
+		return
+	}
+
+	pool := s.GetAuction().GetPool()
+	d := pool.Find(c.Name)
+	if d == nil {
+		writeError(ctx, fasthttp.StatusNotFound, fmt.Errorf("dsp %s not found", c.Name))
+		return
+	}
+
+	// keys of listed settings are masked
+	c.Unmask(d.GetConfig())
+
+	err = pool.Update(c)
+	if err != nil {
+		writeError(ctx, poolErrorCode(err, fasthttp.StatusBadRequest), err)
+		return
+	}
+
+	s.logger.Printf("admin: dsp %s updated", c.Name)
+	writeJson(ctx, pool.Find(c.Name).GetConfig().Masked())
+}
+
+// remove dsp by name query arg
+func (s *Server) DeleteDspRoute(ctx *fasthttp.RequestCtx) {
+
+	name := string(ctx.QueryArgs().Peek("name"))
+	err := s.GetAuction().GetPool().Delete(name)
+	if err != nil {
+		writeError(ctx, poolErrorCode(err, fasthttp.StatusNotFound), err)
+		return
+	}
+
+	s.logger.Printf("admin: dsp %s deleted", name)
+	ctx.SetStatusCode(fasthttp.StatusNoContent)
+}
+
+// pause dsp by name query arg
+func (s *Server) PauseDspRoute(ctx *fasthttp.RequestCtx) {
+	s.pauseDsp(ctx, true)
+}
+
+// resume dsp by name query arg
+func (s *Server) ResumeDspRoute(ctx *fasthttp.RequestCtx) {
+	s.pauseDsp(ctx, false)
+}
+
+func (s *Server) pauseDsp(ctx *fasthttp.RequestCtx, paused bool) {
+
+	name := string(ctx.QueryArgs().Peek("name"))
+	pool := s.GetAuction().GetPool()
+	if pool.Find(name) == nil {
+		writeError(ctx, fasthttp.StatusNotFound, fmt.Errorf("dsp %s not found", name))
+		return
+	}
+
+	err := pool.Pause(name, paused)
+	if err != nil {
+		writeError(ctx, fasthttp.StatusInternalServerError, err)
+		return
+	}
+
+	s.logger.Printf("admin: dsp %s paused %t", name, paused)
+	writeJson(ctx, pool.Find(name).GetConfig().Masked())
+}
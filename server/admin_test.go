@@ -0,0 +1,182 @@
+package server
+
+import (
+	"airpush/auction"
+	"airpush/auction/deal"
+	"airpush/auction/dsp"
+	"airpush/auction/floor"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/valyala/fasthttp"
+)
+
+func TestAdminMiddleWare(t *testing.T) {
+
+	next := func(ctx *fasthttp.RequestCtx) {
+		ctx.SetStatusCode(fasthttp.StatusOK)
+	}
+	handler := adminMiddleWare("secret", next)
+
+	cases := map[string]int{
+		"Bearer secret": fasthttp.StatusOK,
+		"Bearer secre": fasthttp.StatusUnauthorized,
+		"Bearer secret2": fasthttp.StatusUnauthorized,
+		"secret": fasthttp.StatusUnauthorized,
+		"": fasthttp.StatusUnauthorized,
+	}
+
+	for header, code := range cases {
+		ctx := new(fasthttp.RequestCtx)
+		if header != "" {
+			ctx.Request.Header.Set("Authorization", header)
+		}
+
+		handler(ctx)
+		if ctx.Response.StatusCode() != code {
+			t.Fatalf("header %q status %d, want %d", header, ctx.Response.StatusCode(), code)
+		}
+	}
+}
+
+func TestUpdateFloorsRoute(t *testing.T) {
+
+	s := &Server{floors: floor.New(floor.SetRules([]floor.Rule{{Floor: 1}}))}
+
+	cases := map[string]int{
+		`[{"floor":-1}]`: fasthttp.StatusBadRequest,
+		`[{"floor":"NaN"}]`: fasthttp.StatusBadRequest,
+		`[{"floor":2}]`: fasthttp.StatusOK,
+	}
+
+	for body, code := range cases {
+		ctx := new(fasthttp.RequestCtx)
+		ctx.Request.SetBodyString(body)
+
+		s.UpdateFloorsRoute(ctx)
+		if ctx.Response.StatusCode() != code {
+			t.Fatalf("body %s status %d, want %d", body, ctx.Response.StatusCode(), code)
+		}
+	}
+
+	if rules := s.floors.Get(); len(rules) != 1 || rules[0].Floor != 2 {
+		t.Fatalf("unexpected rules %+v", rules)
+	}
+}
+
+func TestUpdateDealsRoute(t *testing.T) {
+
+	s := &Server{deals: deal.New()}
+
+	ctx := new(fasthttp.RequestCtx)
+	ctx.Request.SetBodyString(`[{"id":"d1","floor":-0.5}]`)
+
+	s.UpdateDealsRoute(ctx)
+	if ctx.Response.StatusCode() != fasthttp.StatusBadRequest {
+		t.Fatalf("status %d, want %d", ctx.Response.StatusCode(), fasthttp.StatusBadRequest)
+	}
+
+	if deals := s.deals.Get(); len(deals) != 0 {
+		t.Fatalf("invalid deals applied %+v", deals)
+	}
+}
+
+func TestCreateDspRoute(t *testing.T) {
+
+	missing := filepath.Join(os.TempDir(), "missing-admin-dir", "dsp.yaml")
+
+	cases := []struct {
+		name string
+		path string
+		body string
+		code int
+	}{
+		{"created", "", `{"name":"a","type":"http","addr":"http://127.0.0.1:1/bid","timeout":50}`, fasthttp.StatusOK},
+		{"timeout exceed auction timeout", "", `{"name":"a","type":"http","addr":"http://127.0.0.1:1/bid","timeout":150}`, fasthttp.StatusBadRequest},
+		{"invalid settings", "", `{"name":"a","type":"ftp","addr":"x","timeout":50}`, fasthttp.StatusBadRequest},
+		{"store not written", missing, `{"name":"a","type":"http","addr":"http://127.0.0.1:1/bid","timeout":50}`, fasthttp.StatusInternalServerError},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+
+			pool := dsp.NewPool(dsp.SetPath(c.path))
+			defer pool.Close()
+
+			s := &Server{logger: logrus.New()}
+			s.SwapAuction(auction.New(auction.SetPool(pool), auction.SetTimeout(100 * time.Millisecond)))
+
+			ctx := new(fasthttp.RequestCtx)
+			ctx.Request.SetBodyString(c.body)
+
+			s.CreateDspRoute(ctx)
+			if ctx.Response.StatusCode() != c.code {
+				t.Fatalf("status %d, want %d: %s", ctx.Response.StatusCode(), c.code, ctx.Response.Body())
+			}
+
+			if added := pool.Find("a") != nil; added != (c.code == fasthttp.StatusOK) {
+				t.Fatalf("dsp added %t with status %d", added, c.code)
+			}
+		})
+	}
+}
+
+func TestDspRouteMaskKeys(t *testing.T) {
+
+	encryptionKey := "skU7Ax_NL5pPAFyKdkfZjZz2-VhIN8bjj1rVFOaJ_5o="
+	integrityKey := "arO23ykdNqUQ5LEoQ0FVmPkBd7xB5CO89PDZlSjpFxo="
+
+	d, err := dsp.Build(dsp.Config{Name: "a", Type: "http", Addr: "http://127.0.0.1:1/bid", Timeout: 50,
+		Price: &dsp.PriceConfig{Enabled: true, EncryptionKey: encryptionKey, IntegrityKey: integrityKey}})
+	if err != nil {
+		t.Fatalf("build: %s", err)
+	}
+
+	pool := dsp.NewPool(dsp.SetList([]*dsp.Dsp{d}))
+	defer pool.Close()
+
+	s := &Server{logger: logrus.New()}
+	s.SwapAuction(auction.New(auction.SetPool(pool), auction.SetTimeout(100 * time.Millisecond)))
+
+	routes := map[string]func(ctx *fasthttp.RequestCtx){
+		"list": s.DspRoute,
+		"dsp": s.DspRoute,
+		"pause": s.PauseDspRoute,
+		// listed settings sent back with masked keys
+		"update": s.UpdateDspRoute,
+	}
+
+	for name, route := range routes {
+
+		ctx := new(fasthttp.RequestCtx)
+		if name != "list" {
+			ctx.Request.SetRequestURI("/admin/dsp?name=a")
+		}
+		if name == "update" {
+			ctx.Request.SetBodyString(`{"name":"a","type":"http","addr":"http://127.0.0.1:1/bid","timeout":40,` +
+				`"price":{"enabled":true,"encryption_key":"******","integrity_key":"******"}}`)
+		}
+
+		route(ctx)
+		if ctx.Response.StatusCode() != fasthttp.StatusOK {
+			t.Fatalf("%s status %d: %s", name, ctx.Response.StatusCode(), ctx.Response.Body())
+		}
+
+		body := string(ctx.Response.Body())
+		if strings.Contains(body, encryptionKey) || strings.Contains(body, integrityKey) || !strings.Contains(body, dsp.SECRET_MASK) {
+			t.Fatalf("%s response keys not masked: %s", name, body)
+		}
+	}
+
+	// masked keys kept current keys
+	if p := pool.Find("a").GetConfig().Price; p.EncryptionKey != encryptionKey || p.IntegrityKey != integrityKey {
+		t.Fatalf("keys replaced by mask %+v", p)
+	}
+	if pool.Find("a").GetCrypter() == nil {
+		t.Fatalf("updated dsp has no crypter")
+	}
+}
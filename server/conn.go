@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"net"
+	"syscall"
+	"time"
+)
+
+// interval of client connection checks while auction runs
+const CONN_POLL = 10 * time.Millisecond
+
+// state of client connection
+const (
+	CONN_OPEN = iota
+	CONN_CLOSED
+	CONN_DATA
+)
+
+// cancel auction when client closes connection, stop on done of auction
+// connection is only peeked, pipelined request stays for fasthttp
+func watchConn(actx context.Context, conn net.Conn, cancel context.CancelFunc) <-chan struct{} {
+
+	done := make(chan struct{})
+
+	raw := rawConn(conn)
+	if raw == nil {
+		close(done)
+		return done
+	}
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(CONN_POLL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-actx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			switch peekConn(raw) {
+			case CONN_CLOSED:
+				cancel()
+				return
+			case CONN_DATA:
+				// next request is sent, client still waits
+				return
+			}
+		}
+	}()
+
+	return done
+}
+
+// socket of connection, nil when it can not be peeked
+func rawConn(conn net.Conn) syscall.RawConn {
+
+	// tls connection over socket
+	if c, ok := conn.(interface{ NetConn() net.Conn }); ok {
+		conn = c.NetConn()
+	}
+
+	c, ok := conn.(syscall.Conn)
+	if !ok {
+		return nil
+	}
+
+	raw, err := c.SyscallConn()
+	if err != nil {
+		return nil
+	}
+
+	return raw
+}
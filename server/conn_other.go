@@ -0,0 +1,12 @@
+//go:build !unix
+
+package server
+
+import (
+	"syscall"
+)
+
+// socket peek is not supported, connection is taken as open
+func peekConn(raw syscall.RawConn) int {
+	return CONN_OPEN
+}
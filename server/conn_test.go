@@ -0,0 +1,167 @@
+package server
+
+import (
+	"airpush/auction/openrtb"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// accepted server side and client side of tcp connection
+func testConnPair(t *testing.T) (net.Conn, net.Conn) {
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+	defer lis.Close()
+
+	client, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+
+	conn, err := lis.Accept()
+	if err != nil {
+		t.Fatalf("accept: %s", err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = conn.Close()
+	})
+
+	return conn, client
+}
+
+func TestWatchConnClose(t *testing.T) {
+
+	conn, client := testConnPair(t)
+
+	actx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := watchConn(actx, conn, cancel)
+
+	_ = client.Close()
+
+	select {
+	case <-actx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("auction not cancelled on client close")
+	}
+	<-done
+}
+
+func TestWatchConnData(t *testing.T) {
+
+	conn, client := testConnPair(t)
+
+	actx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := watchConn(actx, conn, cancel)
+
+	// pipelined request
+	if _, err := client.Write([]byte("G")); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watch not stopped on pipelined data")
+	}
+	if actx.Err() != nil {
+		t.Fatal("auction cancelled with client waiting")
+	}
+
+	// data left for server
+	buf := make([]byte, 1)
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	if n, err := conn.Read(buf); err != nil || n != 1 || buf[0] != 'G' {
+		t.Fatalf("read %q, %v, want pipelined data", buf[:n], err)
+	}
+}
+
+func TestWatchConnDone(t *testing.T) {
+
+	conn, _ := testConnPair(t)
+
+	actx, cancel := context.WithCancel(context.Background())
+	done := watchConn(actx, conn, cancel)
+
+	time.Sleep(3 * CONN_POLL)
+	select {
+	case <-done:
+		t.Fatal("watch stopped with client connected")
+	default:
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watch not stopped on auction done")
+	}
+}
+
+func TestAuctionContext(t *testing.T) {
+
+	actx, cancel := auctionContext(&fasthttp.RequestCtx{}, &openrtb.BidRequest{TMax: 100})
+	deadline, ok := actx.Deadline()
+	if !ok || time.Until(deadline) > 100 * time.Millisecond {
+		t.Errorf("deadline %v, want within tmax", deadline)
+	}
+	cancel()
+	if actx.Err() == nil {
+		t.Error("auction not cancelled")
+	}
+
+	actx, cancel = auctionContext(&fasthttp.RequestCtx{}, &openrtb.BidRequest{})
+	defer cancel()
+	if _, ok := actx.Deadline(); ok {
+		t.Error("deadline without tmax")
+	}
+}
+
+func TestAuctionContextDisconnect(t *testing.T) {
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	srv := &fasthttp.Server{Handler: func(ctx *fasthttp.RequestCtx) {
+		actx, cancel := auctionContext(ctx, &openrtb.BidRequest{TMax: 5000})
+		defer cancel()
+
+		close(started)
+		<-actx.Done()
+		if actx.Err() == context.Canceled {
+			close(cancelled)
+		}
+	}}
+	go srv.Serve(lis)
+	defer srv.Shutdown()
+
+	client, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+	if _, err = client.Write([]byte("POST / HTTP/1.1\r\nHost: test\r\nContent-Length: 0\r\n\r\n")); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+
+	<-started
+	_ = client.Close()
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("auction not cancelled on client disconnect")
+	}
+}
@@ -0,0 +1,31 @@
+//go:build unix
+
+package server
+
+import (
+	"syscall"
+)
+
+// state of connection by peek of socket, never block or consume data
+func peekConn(raw syscall.RawConn) int {
+
+	state := CONN_OPEN
+	buf := make([]byte, 1)
+
+	err := raw.Read(func(fd uintptr) bool {
+		n, _, err := syscall.Recvfrom(int(fd), buf, syscall.MSG_PEEK)
+		switch {
+		case err == syscall.EAGAIN || err == syscall.EINTR:
+		case err != nil || n == 0:
+			state = CONN_CLOSED
+		default:
+			state = CONN_DATA
+		}
+		return true
+	})
+	if err != nil {
+		return CONN_CLOSED
+	}
+
+	return state
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"airpush/auction/openrtb"
+	"airpush/client/transport/pb"
+	"context"
+	"net"
+	"time"
+
+	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcBidder fake grpc dsp
+// same behaviour as BidRoute, for run grpc transport without outside services
+type GrpcBidder struct {
+	pb.UnimplementedBidderServer
+	addr string
+	server *grpc.Server
+	logger fasthttp.Logger
+}
+
+// init grpc bidder
+func NewGrpcBidder(addr string, logger fasthttp.Logger) *GrpcBidder {
+
+	proto := &GrpcBidder{
+		addr: addr,
+		server: grpc.NewServer(),
+		logger: logger,
+	}
+
+	pb.RegisterBidderServer(proto.server, proto)
+
+	return proto
+}
+
+// bid response
+func (g *GrpcBidder) Bid(ctx context.Context, in *pb.BidRequest) (*pb.BidResponse, error) {
+
+	req := new(openrtb.BidRequest)
+	err := req.UnmarshalJSON(in.GetPayload())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unmarshal request: %s", err)
+	}
+
+	b, wait := RandBidResponse(req, g.addr)
+
+	// wait before response, but not longer than caller
+	select {
+	case <-time.After(wait):
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	// no bid
+	if b == nil {
+		return &pb.BidResponse{}, nil
+	}
+
+	buf, err := b.MarshalJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal response: %s", err)
+	}
+
+	return &pb.BidResponse{Payload: buf}, nil
+}
+
+// listen and serve
+func (g *GrpcBidder) Start() (err error) {
+
+	lis, err := net.Listen("tcp", g.addr)
+	if err != nil {
+		return
+	}
+
+	g.logger.Printf("listen grpc bidder on: %s\n", g.addr)
+	return g.Serve(lis)
+}
+
+// serve on given listener
+func (g *GrpcBidder) Serve(lis net.Listener) error {
+	return g.server.Serve(lis)
+}
+
+// stop grpc bidder
+func (g *GrpcBidder) Close() {
+	g.logger.Printf("stop grpc bidder")
+	g.server.GracefulStop()
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"airpush/auction/openrtb"
+	"airpush/client"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fake bidder is random, so every outcome is retried up to the limit
+const GRPC_TEST_ATTEMPTS = 500
+
+// bidder served on free local port
+func startGrpcBidder(t *testing.T) string {
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	bidder := NewGrpcBidder(lis.Addr().String(), logger)
+	go bidder.Serve(lis)
+	t.Cleanup(bidder.Close)
+
+	return lis.Addr().String()
+}
+
+// grpc dsp client
+func newGrpcClient(t *testing.T, addr string, timeout time.Duration) *client.Client {
+
+	c, err := client.New(
+		client.SetConnectionType(client.CONN_TYPE_GRPC),
+		client.SetAddr(addr),
+		client.WithTimeout(timeout),
+	)
+	if err != nil {
+		t.Fatalf("client: %s", err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return c
+}
+
+// bid request body
+func grpcTestRequest(t *testing.T) []byte {
+
+	req := &openrtb.BidRequest{
+		ID: "req-1",
+		Imp: []openrtb.Imp{
+			{ID: "imp-1", Banner: &openrtb.Banner{}},
+		},
+		Cur: []string{"USD"},
+	}
+
+	buf, err := req.MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal request: %s", err)
+	}
+
+	return buf
+}
+
+func TestGrpcBid(t *testing.T) {
+
+	addr := startGrpcBidder(t)
+	c := newGrpcClient(t, addr, time.Second)
+	body := grpcTestRequest(t)
+
+	for i := 0; i < GRPC_TEST_ATTEMPTS; i++ {
+
+		buf, err := c.Do(context.Background(), body)
+		if err != nil {
+			t.Fatalf("do: %s", err)
+		}
+
+		// no bid, try again
+		if len(buf) == 0 {
+			continue
+		}
+
+		res := new(openrtb.BidResponse)
+		if err := res.UnmarshalJSON(buf); err != nil {
+			t.Fatalf("unmarshal response: %s", err)
+		}
+
+		if res.ID != "req-1" || res.Cur != "USD" {
+			t.Fatalf("unexpected response id %q cur %q", res.ID, res.Cur)
+		}
+
+		if len(res.SeatBid) != 1 || res.SeatBid[0].Seat != addr {
+			t.Fatalf("unexpected seat bids %+v", res.SeatBid)
+		}
+
+		bids := res.SeatBid[0].Bid
+		if len(bids) != 1 || bids[0].ImpID != "imp-1" || bids[0].Price <= 0 {
+			t.Fatalf("unexpected bids %+v", bids)
+		}
+
+		return
+	}
+
+	t.Fatalf("no bid in %d attempts", GRPC_TEST_ATTEMPTS)
+}
+
+func TestGrpcNoBid(t *testing.T) {
+
+	addr := startGrpcBidder(t)
+	c := newGrpcClient(t, addr, time.Second)
+	body := grpcTestRequest(t)
+
+	for i := 0; i < GRPC_TEST_ATTEMPTS; i++ {
+
+		buf, err := c.Do(context.Background(), body)
+		if err != nil {
+			t.Fatalf("do: %s", err)
+		}
+
+		if len(buf) == 0 {
+			return
+		}
+	}
+
+	t.Fatalf("no empty response in %d attempts", GRPC_TEST_ATTEMPTS)
+}
+
+func TestGrpcDeadline(t *testing.T) {
+
+	// fake bidder waits at least 1ms before answer
+	addr := startGrpcBidder(t)
+	c := newGrpcClient(t, addr, time.Millisecond)
+	body := grpcTestRequest(t)
+
+	for i := 0; i < GRPC_TEST_ATTEMPTS; i++ {
+
+		_, err := c.Do(context.Background(), body)
+		if err == client.ErrTimeout {
+			return
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	t.Fatalf("no timeout in %d attempts", GRPC_TEST_ATTEMPTS)
+}
@@ -1,9 +1,19 @@
 package server
 
 import (
+	"airpush/auction/native"
+	"airpush/auction/openrtb"
+	"fmt"
 	"math/rand"
+	"time"
 )
 
+// fake inline vast of dsp
+const VAST_INLINE = `<VAST version="3.0"><Ad id="%d"><InLine><AdSystem>fake</AdSystem><AdTitle>time wait %d</AdTitle>` +
+	`<Creatives><Creative><Linear><Duration>00:00:15</Duration><MediaFiles>` +
+	`<MediaFile delivery="progressive" type="video/mp4" width="640" height="480"><![CDATA[http://example.com/video.mp4]]></MediaFile>` +
+	`</MediaFiles></Linear></Creative></Creatives></InLine></Ad></VAST>`
+
 func RandFloat(min, max float64) float64 {
 	return min + rand.Float64() * (max - min)
 }
@@ -11,3 +21,98 @@ func RandFloat(min, max float64) float64 {
 func RandInt(min, max int) int {
 	return rand.Intn(max - min) + min
 }
+
+// random dsp answer for every imp of request, nil response means no bid
+func RandBidResponse(req *openrtb.BidRequest, seat string) (*openrtb.BidResponse, time.Duration) {
+
+	// generate random values
+	rate := RandInt(1, 100)
+	wait := time.Duration(rate) * time.Millisecond
+
+	// no bid sometimes
+	if rate%10 == 0 {
+		return nil, wait
+	}
+
+	sb := openrtb.SeatBid{
+		Seat: seat,
+	}
+
+	for _, imp := range req.Imp {
+
+		b := openrtb.Bid{
+			ID: fmt.Sprintf("%s-%d", imp.ID, rate),
+			ImpID: imp.ID,
+			Price: RandFloat(1, 100),
+			AdM: fmt.Sprintf("<div>time wait %d</div>", rate),
+			CrID: fmt.Sprintf("%d", rate),
+			ADomain: []string{"example.com"},
+		}
+
+		// inline vast on video only impression
+		if imp.Video != nil && imp.Banner == nil && imp.Native == nil {
+			b.AdM = fmt.Sprintf(VAST_INLINE, rate, rate)
+		}
+
+		// native markup filling every requested asset
+		if imp.Native != nil && imp.Banner == nil && imp.Video == nil {
+			b.AdM = RandNativeResponse(imp.Native.Request)
+		}
+
+		// bid on first deal sometimes
+		if imp.PMP != nil && len(imp.PMP.Deals) > 0 && rate%2 == 0 {
+			b.DealID = imp.PMP.Deals[0].ID
+			b.Price += imp.PMP.Deals[0].BidFloor
+		}
+
+		sb.Bid = append(sb.Bid, b)
+	}
+
+	res := &openrtb.BidResponse{
+		ID: req.ID,
+		SeatBid: []openrtb.SeatBid{sb},
+	}
+
+	// answer in first accepted currency
+	if len(req.Cur) > 0 {
+		res.Cur = req.Cur[0]
+	}
+
+	return res, wait
+}
+
+// native response filling every asset of native request
+func RandNativeResponse(request string) string {
+
+	nreq, err := native.ParseRequest(request)
+	if err != nil {
+		return ""
+	}
+
+	res := &native.Response{
+		Ver: native.VERSION,
+		Link: &native.Link{URL: "http://example.com"},
+	}
+
+	for _, a := range nreq.Assets {
+		ra := native.ResponseAsset{ID: a.ID}
+		switch {
+		case a.Title != nil:
+			ra.Title = &native.TitleResponse{Text: "fake"}
+		case a.Img != nil:
+			w, h := a.Img.W, a.Img.H
+			if a.Img.WMin > 0 || a.Img.HMin > 0 {
+				w, h = a.Img.WMin, a.Img.HMin
+			}
+			ra.Img = &native.ImageResponse{URL: "http://example.com/img.png", W: w, H: h}
+		case a.Video != nil:
+			ra.Video = &native.VideoResponse{VASTTag: fmt.Sprintf(VAST_INLINE, a.ID, a.ID)}
+		case a.Data != nil:
+			ra.Data = &native.DataResponse{Value: "fake"}
+		}
+		res.Assets = append(res.Assets, ra)
+	}
+
+	buf, _ := res.MarshalJSON()
+	return string(buf)
+}
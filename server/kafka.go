@@ -0,0 +1,264 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/apiversions"
+	"github.com/segmentio/kafka-go/protocol/metadata"
+	"github.com/segmentio/kafka-go/protocol/produce"
+	"github.com/valyala/fasthttp"
+)
+
+// node id of fake broker
+const KAFKA_NODE_ID = 1
+
+// supported api versions of fake broker
+var kafkaVersions = []apiversions.ApiKeyResponse{
+	{ApiKey: int16(protocol.Produce), MinVersion: 0, MaxVersion: 8},
+	{ApiKey: int16(protocol.Metadata), MinVersion: 0, MaxVersion: 8},
+	{ApiKey: int16(protocol.ApiVersions), MinVersion: 0, MaxVersion: 2},
+}
+
+// KafkaBroker fake single node kafka
+// topics have one partition, requested unknown topic is created,
+// produced records are only counted, for run kafka event sink without outside services
+type KafkaBroker struct {
+	addr string
+	listener net.Listener
+	mu sync.Mutex
+	offsets map[string]int64
+	conns map[net.Conn]bool
+	wg sync.WaitGroup
+	logger fasthttp.Logger
+}
+
+// init fake kafka broker with existing topics
+func NewKafkaBroker(addr string, topics []string, logger fasthttp.Logger) *KafkaBroker {
+
+	proto := &KafkaBroker{
+		addr: addr,
+		offsets: make(map[string]int64),
+		conns: make(map[net.Conn]bool),
+		logger: logger,
+	}
+
+	for _, topic := range topics {
+		proto.offsets[topic] = 0
+	}
+
+	return proto
+}
+
+// listen and serve
+func (k *KafkaBroker) Start() (err error) {
+
+	lis, err := net.Listen("tcp", k.addr)
+	if err != nil {
+		return
+	}
+
+	k.logger.Printf("listen fake kafka on: %s\n", k.addr)
+	return k.Serve(lis)
+}
+
+// serve on given listener
+func (k *KafkaBroker) Serve(lis net.Listener) error {
+
+	k.mu.Lock()
+	k.listener = lis
+	k.mu.Unlock()
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return nil
+		}
+
+		k.mu.Lock()
+		k.conns[conn] = true
+		k.mu.Unlock()
+
+		k.wg.Add(1)
+		go k.serve(conn)
+	}
+}
+
+// stop fake kafka
+func (k *KafkaBroker) Close() {
+
+	k.mu.Lock()
+	if k.listener != nil {
+		_ = k.listener.Close()
+	}
+	for conn := range k.conns {
+		_ = conn.Close()
+	}
+	k.mu.Unlock()
+
+	k.wg.Wait()
+
+	for topic, n := range k.GetOffsets() {
+		k.logger.Printf("stop fake kafka, topic %s has %d records", topic, n)
+	}
+}
+
+// records count by topic
+func (k *KafkaBroker) GetOffsets() map[string]int64 {
+	defer k.mu.Unlock()
+	k.mu.Lock()
+
+	res := make(map[string]int64, len(k.offsets))
+	for topic, n := range k.offsets {
+		res[topic] = n
+	}
+	return res
+}
+
+// request loop of connection
+func (k *KafkaBroker) serve(conn net.Conn) {
+	defer k.wg.Done()
+	defer func() {
+		k.mu.Lock()
+		delete(k.conns, conn)
+		k.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	r := bufio.NewReader(conn)
+	for {
+		version, id, _, msg, err := protocol.ReadRequest(r)
+		if err != nil {
+			if err != io.EOF {
+				k.logger.Printf("fake kafka read request fail: %s", err)
+			}
+			return
+		}
+
+		res, err := k.handle(msg)
+		if err != nil {
+			k.logger.Printf("fake kafka %s", err)
+			return
+		}
+		if res == nil {
+			continue
+		}
+
+		err = protocol.WriteResponse(conn, version, id, res)
+		if err != nil {
+			k.logger.Printf("fake kafka write response fail: %s", err)
+			return
+		}
+	}
+}
+
+// response on request, nil for no response
+func (k *KafkaBroker) handle(msg protocol.Message) (protocol.Message, error) {
+
+	switch req := msg.(type) {
+
+	case *apiversions.Request:
+		return &apiversions.Response{ApiKeys: kafkaVersions}, nil
+
+	case *metadata.Request:
+		host, port := k.hostPort()
+		res := &metadata.Response{
+			Brokers: []metadata.ResponseBroker{{NodeID: KAFKA_NODE_ID, Host: host, Port: port}},
+			ControllerID: KAFKA_NODE_ID,
+		}
+		for _, topic := range k.topics(req.TopicNames) {
+			res.Topics = append(res.Topics, metadata.ResponseTopic{
+				Name: topic,
+				Partitions: []metadata.ResponsePartition{{
+					PartitionIndex: 0,
+					LeaderID: KAFKA_NODE_ID,
+					ReplicaNodes: []int32{KAFKA_NODE_ID},
+					IsrNodes: []int32{KAFKA_NODE_ID},
+				}},
+			})
+		}
+		return res, nil
+
+	case *produce.Request:
+		res := &produce.Response{}
+		for _, t := range req.Topics {
+			rt := produce.ResponseTopic{Topic: t.Topic}
+			for _, p := range t.Partitions {
+				rt.Partitions = append(rt.Partitions, produce.ResponsePartition{
+					Partition: p.Partition,
+					BaseOffset: k.append(t.Topic, p.RecordSet),
+				})
+			}
+			res.Topics = append(res.Topics, rt)
+		}
+		if !req.HasResponse() {
+			return nil, nil
+		}
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("unsupported request: %s", msg.ApiKey())
+}
+
+// topics of metadata request, all when no one is requested
+func (k *KafkaBroker) topics(names []string) []string {
+	defer k.mu.Unlock()
+	k.mu.Lock()
+
+	if names == nil {
+		for topic := range k.offsets {
+			names = append(names, topic)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	for _, topic := range names {
+		if _, ok := k.offsets[topic]; !ok {
+			k.offsets[topic] = 0
+		}
+	}
+
+	return names
+}
+
+// count records of set, return base offset
+func (k *KafkaBroker) append(topic string, set protocol.RecordSet) int64 {
+
+	var n int64
+	if set.Records != nil {
+		for {
+			if _, err := set.Records.ReadRecord(); err != nil {
+				break
+			}
+			n++
+		}
+	}
+
+	defer k.mu.Unlock()
+	k.mu.Lock()
+
+	base := k.offsets[topic]
+	k.offsets[topic] += n
+
+	return base
+}
+
+// advertised address
+func (k *KafkaBroker) hostPort() (string, int32) {
+
+	host, port, err := net.SplitHostPort(k.listener.Addr().String())
+	if err != nil {
+		return "", 0
+	}
+
+	p, _ := strconv.Atoi(port)
+
+	return host, int32(p)
+}
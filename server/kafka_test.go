@@ -0,0 +1,102 @@
+package server
+
+import (
+	"airpush/auction/event"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fake broker served on free local port
+func startKafkaBroker(t *testing.T, topics []string) (*KafkaBroker, string) {
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+
+	logger := logrus.New()
+	logger.SetLevel(logrus.ErrorLevel)
+
+	broker := NewKafkaBroker(lis.Addr().String(), topics, logger)
+	go broker.Serve(lis)
+	t.Cleanup(broker.Close)
+
+	return broker, lis.Addr().String()
+}
+
+func TestKafkaSinkOffsets(t *testing.T) {
+
+	const n = 250
+	broker, addr := startKafkaBroker(t, []string{"events"})
+
+	k, err := event.NewKafka(
+		event.SetKafkaBrokers([]string{addr}),
+		event.SetKafkaTopic("events"),
+		event.SetKafkaBatchSize(100),
+		event.SetKafkaBatchTimeout(10 * time.Millisecond),
+		event.SetKafkaCompression(event.COMPRESSION_LZ4),
+	)
+	if err != nil {
+		t.Fatalf("kafka: %s", err)
+	}
+	k.Start()
+
+	for i := 0; i < n; i++ {
+		if !k.Write(&event.Record{Type: event.TYPE_AUCTION, ID: fmt.Sprintf("auction-%d", i)}) {
+			t.Fatalf("event %d dropped", i)
+		}
+	}
+
+	// close produce queued and pending events
+	if err := k.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+
+	if offset := broker.GetOffsets()["events"]; offset != n {
+		t.Fatalf("offset %d, want %d", offset, n)
+	}
+
+	if k.GetDropped() != 0 || k.GetFailed() != 0 || k.GetInFlight() != 0 {
+		t.Fatalf("dropped %d failed %d in flight %d", k.GetDropped(), k.GetFailed(), k.GetInFlight())
+	}
+}
+
+func TestKafkaSinkDropOnFull(t *testing.T) {
+
+	const queue = 10
+	broker, addr := startKafkaBroker(t, []string{"events"})
+
+	k, err := event.NewKafka(
+		event.SetKafkaBrokers([]string{addr}),
+		event.SetKafkaTopic("events"),
+		event.SetKafkaQueueSize(queue),
+	)
+	if err != nil {
+		t.Fatalf("kafka: %s", err)
+	}
+
+	// producer not started, queue is filled
+	written := 0
+	for i := 0; i < queue + 5; i++ {
+		if k.Write(&event.Record{Type: event.TYPE_AUCTION, ID: fmt.Sprintf("auction-%d", i)}) {
+			written++
+		}
+	}
+
+	if written != queue || k.GetDropped() != 5 {
+		t.Fatalf("written %d dropped %d, want %d and 5", written, k.GetDropped(), queue)
+	}
+
+	k.Start()
+	if err := k.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+
+	if offset := broker.GetOffsets()["events"]; offset != queue {
+		t.Fatalf("offset %d, want %d", offset, queue)
+	}
+}
@@ -2,12 +2,22 @@ package server
 
 import (
 	"airpush/auction"
-	"airpush/auction/bid"
+	"airpush/auction/currency"
+	"airpush/auction/deal"
+	"airpush/auction/event"
+	"airpush/auction/floor"
+	"airpush/auction/metrics"
+	"airpush/auction/notice"
+	"airpush/auction/openrtb"
+	"airpush/auction/tracking"
+	"airpush/auction/vast"
+	"context"
 	"fmt"
 	"github.com/fasthttp/router"
 	"github.com/valyala/fasthttp"
 	"net/url"
 	"os"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,6 +33,7 @@ type ServerSettings struct {
 	WriteTimeout time.Duration
 	Concurrency int
 	DisableKeepalive bool
+	AdminToken string
 }
 
 // settings setter
@@ -39,7 +50,78 @@ func SetServerName(name string) ServerSetOption {
 // auction
 func SetAuction(auction *auction.Auction) ServerSetOption {
 	return func(s *Server) {
-		s.auction = auction
+		s.auction.Store(auction)
+	}
+}
+
+// floor rules managed by admin api
+func SetFloors(floors *floor.Floors) ServerSetOption {
+	return func(s *Server) {
+		s.floors = floors
+	}
+}
+
+// deals registry managed by admin api
+func SetDeals(deals *deal.Deals) ServerSetOption {
+	return func(s *Server) {
+		s.deals = deals
+	}
+}
+
+// exchange rates managed by admin api
+func SetRates(rates *currency.Rates) ServerSetOption {
+	return func(s *Server) {
+		s.rates = rates
+	}
+}
+
+// store of winning vast documents, nil disable vast endpoint
+func SetVast(store *vast.Store) ServerSetOption {
+	return func(s *Server) {
+		s.vast = store
+	}
+}
+
+// exchange impression and tracking event urls injected in vast
+func SetVastTracking(impressions []string, events map[string][]string) ServerSetOption {
+	return func(s *Server) {
+		s.vastImpressions = impressions
+		s.vastEvents = events
+	}
+}
+
+// prometheus metrics, nil disable metrics endpoint
+func SetMetrics(metrics *metrics.Metrics) ServerSetOption {
+	return func(s *Server) {
+		s.metrics = metrics
+	}
+}
+
+// tracking tokens, nil disable tracking endpoints
+func SetTracker(tracker *tracking.Tracker) ServerSetOption {
+	return func(s *Server) {
+		s.tracker = tracker
+	}
+}
+
+// sink of tracking events
+func SetEvents(events event.Sink) ServerSetOption {
+	return func(s *Server) {
+		s.events = events
+	}
+}
+
+// billing notices of rendered impressions
+func SetNotifier(notifier *notice.Notifier) ServerSetOption {
+	return func(s *Server) {
+		s.notifier = notifier
+	}
+}
+
+// admin api token, empty token disable admin api
+func SetAdminToken(token string) ServerSetOption {
+	return func(s *Server) {
+		s.settings.AdminToken = token
 	}
 }
 
@@ -163,7 +245,17 @@ func defaultMiddleWare(next fasthttp.RequestHandler) fasthttp.RequestHandler {
 type Server struct {
 	settings ServerSettings
 	server *fasthttp.Server
-	auction *auction.Auction
+	auction atomic.Value
+	floors *floor.Floors
+	deals *deal.Deals
+	rates *currency.Rates
+	vast *vast.Store
+	vastImpressions []string
+	vastEvents map[string][]string
+	metrics *metrics.Metrics
+	tracker *tracking.Tracker
+	events event.Sink
+	notifier *notice.Notifier
 	logger fasthttp.Logger
 }
 
@@ -185,10 +277,55 @@ func New(opts ...ServerSetOption) (proto *Server, err error) {
 	routing.GET("/ping", proto.PingRoute)
 
 	// bid
-	routing.GET("/bid", proto.BidRoute)
+	routing.POST("/bid", proto.BidRoute)
 
 	// auction
-	routing.GET("/", proto.AuctionRoute)
+	routing.POST("/", proto.AuctionRoute)
+
+	// prometheus metrics
+	if proto.metrics != nil {
+		routing.GET("/metrics", proto.MetricsRoute)
+	}
+
+	// winning vast
+	if proto.vast != nil {
+		routing.GET("/vast", proto.VastRoute)
+	}
+
+	// impression, click and event tracking
+	if proto.tracker != nil {
+		routing.GET("/"+tracking.KIND_IMP, proto.ImpRoute)
+		routing.GET("/"+tracking.KIND_CLICK, proto.ClickRoute)
+		routing.GET("/"+tracking.KIND_EVENT, proto.EventRoute)
+	}
+
+	// admin
+	if token := proto.settings.AdminToken; token != "" {
+		routing.GET("/admin/stats", adminMiddleWare(token, proto.StatsRoute))
+		routing.GET("/admin/breakers", adminMiddleWare(token, proto.BreakersRoute))
+
+		routing.GET("/admin/dsp", adminMiddleWare(token, proto.DspRoute))
+		routing.POST("/admin/dsp", adminMiddleWare(token, proto.CreateDspRoute))
+		routing.PUT("/admin/dsp", adminMiddleWare(token, proto.UpdateDspRoute))
+		routing.DELETE("/admin/dsp", adminMiddleWare(token, proto.DeleteDspRoute))
+		routing.POST("/admin/dsp/pause", adminMiddleWare(token, proto.PauseDspRoute))
+		routing.POST("/admin/dsp/resume", adminMiddleWare(token, proto.ResumeDspRoute))
+
+		if proto.floors != nil {
+			routing.GET("/admin/floors", adminMiddleWare(token, proto.FloorsRoute))
+			routing.PUT("/admin/floors", adminMiddleWare(token, proto.UpdateFloorsRoute))
+		}
+
+		if proto.deals != nil {
+			routing.GET("/admin/deals", adminMiddleWare(token, proto.DealsRoute))
+			routing.PUT("/admin/deals", adminMiddleWare(token, proto.UpdateDealsRoute))
+		}
+
+		if proto.rates != nil {
+			routing.GET("/admin/rates", adminMiddleWare(token, proto.RatesRoute))
+			routing.PUT("/admin/rates", adminMiddleWare(token, proto.UpdateRatesRoute))
+		}
+	}
 
 	// определяем сервер
 	proto.server = &fasthttp.Server{
@@ -215,36 +352,80 @@ func (s *Server) PingRoute(ctx *fasthttp.RequestCtx) {
 }
 
 // bid response
+// fake dsp, answer random price for every imp of openrtb request
 func (s *Server) BidRoute(ctx *fasthttp.RequestCtx) {
 
-	// generate random values
-	rate := RandInt(1, 100)
-	cpm := RandFloat(1, 100)
-
-	b := bid.BidResponse{
-		Cpm: cpm,
-		Wait: fmt.Sprintf("%d", rate),
+	req := new(openrtb.BidRequest)
+	err := req.UnmarshalJSON(ctx.PostBody())
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
 	}
 
+	b, wait := RandBidResponse(req, string(ctx.Host()))
+
 	// wait before response
-	time.Sleep(time.Duration(rate) * time.Millisecond)
+	time.Sleep(wait)
+
+	// no bid sometimes
+	if b == nil {
+		ctx.SetStatusCode(fasthttp.StatusNoContent)
+		return
+	}
+
+	// notices back to ping route
+	for i := range b.SeatBid[0].Bid {
+		b.SeatBid[0].Bid[i].NURL = fmt.Sprintf("http://%s/ping?win=${AUCTION_PRICE}&id=${AUCTION_ID}", ctx.Host())
+		b.SeatBid[0].Bid[i].LURL = fmt.Sprintf("http://%s/ping?loss=${AUCTION_LOSS}&id=${AUCTION_ID}", ctx.Host())
+	}
 
 	buf, _ := b.MarshalJSON()
 	_, _ = ctx.Write(buf)
 }
 
+// prometheus metrics
+func (s *Server) MetricsRoute(ctx *fasthttp.RequestCtx) {
+
+	ctx.SetContentType(string(metrics.FORMAT))
+	err := s.metrics.Write(ctx)
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+		s.logger.Printf("err metrics: %s", err)
+	}
+}
+
 // auction
 func (s *Server) AuctionRoute(ctx *fasthttp.RequestCtx) {
-	
+
+	// parse openrtb request
+	req := new(openrtb.BidRequest)
+	err := req.UnmarshalJSON(ctx.PostBody())
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		s.logger.Printf("err unmarshal request: %s", err)
+		return
+	}
+
+	err = req.Validate()
+	if err != nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		s.logger.Printf("err validate request: %s", err)
+		return
+	}
+
+	// auction deadline from request tmax, cancelled on client disconnect
+	actx, cancel := auctionContext(ctx, req)
+	defer cancel()
+
 	//run auction
-	b, err := s.auction.Do()
+	res, err := s.GetAuction().Do(actx, req)
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusNoContent)
 		s.logger.Printf("err auction: %s", err)
 		return
 	}
 
-	buf, err := b.GetRes().MarshalJSON()
+	buf, err := res.MarshalJSON()
 	if err != nil {
 		ctx.SetStatusCode(fasthttp.StatusNoContent)
 		s.logger.Printf("err marshal: %s", err)
@@ -255,6 +436,37 @@ func (s *Server) AuctionRoute(ctx *fasthttp.RequestCtx) {
 	_, _ = ctx.Write(buf)
 }
 
+// context of auction bounded by tmax of request and cancelled when client closes connection
+// not built on fasthttp ctx, it is reused after handler return and done only on server shutdown
+func auctionContext(ctx *fasthttp.RequestCtx, req *openrtb.BidRequest) (context.Context, context.CancelFunc) {
+
+	var actx context.Context
+	var cancel context.CancelFunc
+	if req.TMax > 0 {
+		actx, cancel = context.WithTimeout(context.Background(), time.Duration(req.TMax) * time.Millisecond)
+	} else {
+		actx, cancel = context.WithCancel(context.Background())
+	}
+
+	// connection watch is over before handler return
+	done := watchConn(actx, ctx.Conn(), cancel)
+
+	return actx, func() {
+		cancel()
+		<-done
+	}
+}
+
+// get current auction
+func (s *Server) GetAuction() *auction.Auction {
+	return s.auction.Load().(*auction.Auction)
+}
+
+// replace auction, requests in flight finish on old one
+func (s *Server) SwapAuction(a *auction.Auction) {
+	s.auction.Store(a)
+}
+
 // loop server
 func (s *Server) Start() (err error) {
 	s.logger.Printf("listen server on: %s\n", s.settings.ServerAddr)
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"airpush/auction/event"
+	"airpush/auction/notice"
+	"airpush/auction/tracking"
+	"regexp"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// tracking fire states
+const TRACK_OK = "ok"
+const TRACK_DUPLICATE = "duplicate"
+const TRACK_INVALID = "invalid"
+const TRACK_EXPIRED = "expired"
+
+// transparent 1x1 gif served by impression pixel
+var PIXEL = []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\x00\x00\x00\x00\x00\x00!\xf9\x04\x01\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+
+// custom event name
+var eventName = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// rendered impression, first fire is billable
+// GET /imp?t=<token>
+func (s *Server) ImpRoute(ctx *fasthttp.RequestCtx) {
+
+	token, status := s.fire(ctx, tracking.KIND_IMP, "")
+	if token == nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	if status == TRACK_OK {
+		s.metrics.Billable(token.Dsp, token.Price)
+		if s.notifier != nil && token.BURL != "" {
+			s.notifier.Send(notice.Notice{
+				Kind: notice.KIND_BILLING,
+				URL: token.BURL,
+				AuctionID: token.AuctionID,
+				ImpID: token.ImpID,
+				Dsp: token.Dsp,
+			})
+		}
+	}
+
+	ctx.Response.Header.Set("Cache-Control", "no-store")
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetContentType("image/gif")
+	_, _ = ctx.Write(PIXEL)
+}
+
+// click, redirect to landing of token or to url param on domain of offer
+// GET /click?t=<token>&url=<landing>
+func (s *Server) ClickRoute(ctx *fasthttp.RequestCtx) {
+
+	token, err := s.tracker.Parse(string(ctx.QueryArgs().Peek(tracking.PARAM_TOKEN)))
+	if err != nil {
+		s.record(ctx, tracking.KIND_CLICK, "", nil, trackStatus(err))
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	landing, ok := s.tracker.Landing(token, string(ctx.QueryArgs().Peek(tracking.PARAM_URL)))
+	if !ok {
+		s.record(ctx, tracking.KIND_CLICK, "", token, TRACK_INVALID)
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	status := TRACK_OK
+	if !s.tracker.First(tracking.Key(tracking.KIND_CLICK, "", token)) {
+		status = TRACK_DUPLICATE
+	}
+	s.record(ctx, tracking.KIND_CLICK, "", token, status)
+
+	// repeated click still reach landing
+	ctx.Response.Header.Set("Cache-Control", "no-store")
+	ctx.Redirect(landing, fasthttp.StatusFound)
+}
+
+// custom creative event
+// GET /event?t=<token>&e=<name>
+func (s *Server) EventRoute(ctx *fasthttp.RequestCtx) {
+
+	name := string(ctx.QueryArgs().Peek(tracking.PARAM_EVENT))
+	if !eventName.MatchString(name) {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	token, _ := s.fire(ctx, tracking.KIND_EVENT, name)
+	if token == nil {
+		ctx.SetStatusCode(fasthttp.StatusBadRequest)
+		return
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusNoContent)
+}
+
+// verify token and dedupe fire, nil token when invalid
+func (s *Server) fire(ctx *fasthttp.RequestCtx, kind, name string) (*tracking.Token, string) {
+
+	token, err := s.tracker.Parse(string(ctx.QueryArgs().Peek(tracking.PARAM_TOKEN)))
+	if err != nil {
+		status := trackStatus(err)
+		s.record(ctx, kind, name, nil, status)
+		return nil, status
+	}
+
+	status := TRACK_OK
+	if !s.tracker.First(tracking.Key(kind, name, token)) {
+		status = TRACK_DUPLICATE
+	}
+	s.record(ctx, kind, name, token, status)
+
+	return token, status
+}
+
+// count fire and write it to events, rejected token is only counted
+func (s *Server) record(ctx *fasthttp.RequestCtx, kind, name string, token *tracking.Token, status string) {
+
+	s.metrics.Track(kind, status)
+	if a := s.GetAuction(); a != nil {
+		a.GetStats().Inc("tracking." + kind + "." + status)
+	}
+
+	if s.events == nil || token == nil {
+		return
+	}
+
+	s.events.Write(&event.Tracking{
+		Type: event.TYPE_TRACKING,
+		Time: time.Now().UnixNano() / int64(time.Millisecond),
+		Kind: kind,
+		Event: name,
+		AuctionID: token.AuctionID,
+		ImpID: token.ImpID,
+		BidID: token.BidID,
+		Dsp: token.Dsp,
+		Price: token.Price,
+		Cur: token.Cur,
+		Status: status,
+		Billable: kind == tracking.KIND_IMP && status == TRACK_OK,
+		IP: ctx.RemoteIP().String(),
+	})
+}
+
+// fire status of token error
+func trackStatus(err error) string {
+	if err == tracking.ErrExpiredToken {
+		return TRACK_EXPIRED
+	}
+	return TRACK_INVALID
+}
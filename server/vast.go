@@ -0,0 +1,44 @@
+package server
+
+import (
+	"airpush/auction/notice"
+	"airpush/auction/vast"
+
+	"github.com/valyala/fasthttp"
+)
+
+const CONTENT_TYPE_XML = "application/xml; charset=utf-8"
+
+// winning vast document of auction impression with exchange tracking
+// GET /vast?id=<auction id>&imp=<impression id>
+func (s *Server) VastRoute(ctx *fasthttp.RequestCtx) {
+
+	ad, ok := s.vast.Get(string(ctx.QueryArgs().Peek("id")), string(ctx.QueryArgs().Peek("imp")))
+	if !ok {
+		ctx.SetStatusCode(fasthttp.StatusNotFound)
+		return
+	}
+
+	m := &notice.Macros{
+		AuctionID: ad.AuctionID,
+		ImpID: ad.ImpID,
+		Price: ad.Price,
+		Currency: ad.Currency,
+	}
+
+	impressions := make([]string, 0, len(s.vastImpressions))
+	for _, u := range s.vastImpressions {
+		impressions = append(impressions, m.Substitute(u))
+	}
+
+	events := make(map[string][]string, len(s.vastEvents))
+	for event, urls := range s.vastEvents {
+		for _, u := range urls {
+			events[event] = append(events[event], m.Substitute(u))
+		}
+	}
+
+	ctx.SetStatusCode(fasthttp.StatusOK)
+	ctx.SetContentType(CONTENT_TYPE_XML)
+	_, _ = ctx.WriteString(vast.Inject(ad.Doc, impressions, events))
+}